@@ -0,0 +1,160 @@
+package apitest_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+func TestEventBuilders(t *testing.T) {
+	events := map[api.EventType]api.Event{
+		api.EventTypeGasCredit:                           apitest.GasCreditEvent().Build(),
+		api.EventTypeGasRefunded:                         apitest.GasRefundedEvent().Build(),
+		api.EventTypeCall:                                apitest.CallEvent().Build(),
+		api.EventTypeMessageApproved:                     apitest.MessageApprovedEvent().Build(),
+		api.EventTypeMessageExecuted:                     apitest.MessageExecutedEvent().Build(),
+		api.EventTypeMessageExecutedV2:                   apitest.MessageExecutedEventV2().Build(),
+		api.EventTypeCannotExecuteMessage:                apitest.CannotExecuteMessageEvent().Build(),
+		api.EventTypeCannotExecuteMessageV2:              apitest.CannotExecuteMessageEventV2().Build(),
+		api.EventTypeCannotRouteMessage:                  apitest.CannotRouteMessageEvent().Build(),
+		api.EventTypeCannotExecuteTask:                   apitest.CannotExecuteTaskEvent().Build(),
+		api.EventTypeSignersRotated:                      apitest.SignersRotatedEvent().Build(),
+		api.EventTypeITSLinkTokenStarted:                 apitest.ITSLinkTokenStartedEvent().Build(),
+		api.EventTypeITSTokenMetadataRegistered:          apitest.ITSTokenMetadataRegisteredEvent().Build(),
+		api.EventTypeITSInterchainTokenDeploymentStarted: apitest.ITSInterchainTokenDeploymentStartedEvent().Build(),
+		api.EventTypeITSInterchainTransfer:               apitest.ITSInterchainTransferEvent().Build(),
+		api.EventTypeAppInterchainTransferSent:           apitest.AppInterchainTransferSentEvent().Build(),
+		api.EventTypeAppInterchainTransferReceived:       apitest.AppInterchainTransferReceivedEvent().Build(),
+	}
+
+	for eventType, event := range events {
+		t.Run(string(eventType), func(t *testing.T) {
+			assert.Equal(t, eventType, event.Type)
+			assert.NoError(t, event.Validate())
+			assert.NoError(t, uuid.Validate(event.EventID()))
+
+			var decoded api.Event
+			require.NoError(t, json.Unmarshal(funcs.Must(json.Marshal(event)), &decoded))
+			assert.Equal(t, eventType, decoded.Type)
+			assert.Equal(t, event.EventID(), decoded.EventID())
+			assert.NoError(t, decoded.Validate())
+		})
+	}
+}
+
+func TestEventBuilder_With(t *testing.T) {
+	builder := apitest.CallEvent().With(func(e *api.CallEvent) {
+		e.EventID = "custom"
+		e.DestinationChain = "custom-chain"
+	})
+
+	event := builder.Build()
+	assert.Equal(t, "custom", event.EventID())
+
+	call := funcs.Must(event.AsCallEvent())
+	assert.Equal(t, "custom-chain", call.DestinationChain)
+	assert.Equal(t, apitest.Keccak256(call.Payload), call.Message.PayloadHash)
+	assert.Equal(t, builder.Value(), call)
+}
+
+func TestTaskBuilders(t *testing.T) {
+	items := []api.TaskItem{
+		apitest.ConstructProofTask().Build(),
+		apitest.ExecuteTask().Build(),
+		apitest.GatewayTransactionTask().Build(),
+		apitest.ReactToWasmEventTask().Build(),
+		apitest.RefundTask().Build(),
+		apitest.VerifyTask().Build(),
+		apitest.ReactToExpiredSigningSessionTask().Build(),
+		apitest.ReactToRetriablePollTask().Build(),
+	}
+
+	for _, item := range items {
+		t.Run(string(item.Type), func(t *testing.T) {
+			taskJSON := funcs.Must(json.Marshal(item.Task))
+
+			var rebuilt api.TaskItem
+			require.NoError(t, rebuilt.SetTaskFromJSON(item.Type, string(taskJSON)))
+			assert.JSONEq(t, string(taskJSON), string(funcs.Must(json.Marshal(rebuilt.Task))))
+
+			var decoded api.TaskItem
+			require.NoError(t, json.Unmarshal(funcs.Must(json.Marshal(item)), &decoded))
+			assert.Equal(t, item.ID, decoded.ID)
+			assert.Equal(t, item.Type, decoded.Type)
+			assert.True(t, item.Timestamp.Equal(decoded.Timestamp))
+		})
+	}
+}
+
+func TestTaskBuilder_WithItem(t *testing.T) {
+	id := uuid.New()
+
+	item := apitest.VerifyTask().
+		With(func(task *api.VerifyTask) {
+			task.DestinationChain = "axelar"
+		}).
+		WithItem(func(item *api.TaskItem) {
+			item.ID = id
+			item.Chain = "ethereum"
+		}).
+		Build()
+
+	assert.Equal(t, id, item.ID)
+	assert.Equal(t, "ethereum", item.Chain)
+	assert.Equal(t, api.TaskTypeVerify, item.Type)
+	assert.Equal(t, "axelar", funcs.Must(item.Task.AsVerifyTask()).DestinationChain)
+}
+
+func TestReactToWasmEventTask(t *testing.T) {
+	task := funcs.Must(apitest.ReactToWasmEventTask().Build().Task.AsReactToWasmEventTask())
+
+	var quorumReached struct {
+		Status api.WasmVerificationStatus `wasm:"status"`
+	}
+	require.NoError(t, task.Event.Decode(&quorumReached))
+
+	assert.Equal(t, api.WasmVerificationStatus("succeeded_on_source_chain"), quorumReached.Status, "status must be serialized like contracts do")
+	assert.Equal(t, api.VerificationStatusSucceededOnSourceChain, quorumReached.Status.VerificationStatus())
+}
+
+func TestRandomEvent(t *testing.T) {
+	t.Run("should be deterministic", func(t *testing.T) {
+		first := funcs.Must(json.Marshal(apitest.EventFromSeed(42)))
+		second := funcs.Must(json.Marshal(apitest.EventFromSeed(42)))
+		assert.JSONEq(t, string(first), string(second))
+
+		assert.Equal(t,
+			funcs.Must(json.Marshal(apitest.TaskItemFromSeed(7))),
+			funcs.Must(json.Marshal(apitest.TaskItemFromSeed(7))),
+		)
+	})
+
+	t.Run("should generate valid events with testing/quick", func(t *testing.T) {
+		property := func(event apitest.QuickEvent) bool {
+			return event.Validate() == nil && event.EventID() != ""
+		}
+
+		require.NoError(t, quick.Check(property, &quick.Config{
+			MaxCount: 500,
+			Rand:     rand.New(rand.NewSource(1)),
+		}))
+	})
+
+	t.Run("should generate tasks with testing/quick", func(t *testing.T) {
+		property := func(item apitest.QuickTaskItem) bool {
+			var rebuilt api.TaskItem
+			return rebuilt.SetTaskFromJSON(item.Type, string(funcs.Must(json.Marshal(item.Task)))) == nil
+		}
+
+		require.NoError(t, quick.Check(property, nil))
+	})
+}
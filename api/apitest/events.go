@@ -0,0 +1,410 @@
+package apitest
+
+import (
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+// EventBuilder builds an api.Event of the variant T
+type EventBuilder[T any] struct {
+	value  T
+	setter func(*api.Event, T) error
+}
+
+func newEventBuilder[T any](value T, setter func(*api.Event, T) error) *EventBuilder[T] {
+	return &EventBuilder[T]{
+		value:  value,
+		setter: setter,
+	}
+}
+
+// With applies overrides to the event, e.g. to set a particular eventID or to clear an optional field
+func (b *EventBuilder[T]) With(overrides ...func(*T)) *EventBuilder[T] {
+	for _, override := range overrides {
+		override(&b.value)
+	}
+
+	return b
+}
+
+// Value returns the event variant as it's currently configured
+func (b *EventBuilder[T]) Value() T {
+	return b.value
+}
+
+// Build returns the event wrapped into api.Event
+func (b *EventBuilder[T]) Build() api.Event {
+	var event api.Event
+	funcs.MustNoErr(b.setter(&event, b.value))
+
+	return event
+}
+
+// GasCreditEvent returns a builder of GAS_CREDIT events
+func GasCreditEvent() *EventBuilder[api.GasCreditEvent] {
+	return newGasCreditEvent(defaultGenerator())
+}
+
+// GasRefundedEvent returns a builder of GAS_REFUNDED events
+func GasRefundedEvent() *EventBuilder[api.GasRefundedEvent] {
+	return newGasRefundedEvent(defaultGenerator())
+}
+
+// CallEvent returns a builder of CALL events. Message.PayloadHash is the keccak256 hash of Payload.
+func CallEvent() *EventBuilder[api.CallEvent] {
+	return newCallEvent(defaultGenerator())
+}
+
+// MessageApprovedEvent returns a builder of MESSAGE_APPROVED events
+func MessageApprovedEvent() *EventBuilder[api.MessageApprovedEvent] {
+	return newMessageApprovedEvent(defaultGenerator())
+}
+
+// MessageExecutedEvent returns a builder of MESSAGE_EXECUTED events
+func MessageExecutedEvent() *EventBuilder[api.MessageExecutedEvent] {
+	return newMessageExecutedEvent(defaultGenerator())
+}
+
+// MessageExecutedEventV2 returns a builder of MESSAGE_EXECUTED/V2 events
+func MessageExecutedEventV2() *EventBuilder[api.MessageExecutedEventV2] {
+	return newMessageExecutedEventV2(defaultGenerator())
+}
+
+// CannotExecuteMessageEvent returns a builder of CANNOT_EXECUTE_MESSAGE events
+func CannotExecuteMessageEvent() *EventBuilder[api.CannotExecuteMessageEvent] {
+	return newCannotExecuteMessageEvent(defaultGenerator())
+}
+
+// CannotExecuteMessageEventV2 returns a builder of CANNOT_EXECUTE_MESSAGE/V2 events
+func CannotExecuteMessageEventV2() *EventBuilder[api.CannotExecuteMessageEventV2] {
+	return newCannotExecuteMessageEventV2(defaultGenerator())
+}
+
+// CannotRouteMessageEvent returns a builder of CANNOT_ROUTE_MESSAGE events
+func CannotRouteMessageEvent() *EventBuilder[api.CannotRouteMessageEvent] {
+	return newCannotRouteMessageEvent(defaultGenerator())
+}
+
+// CannotExecuteTaskEvent returns a builder of CANNOT_EXECUTE_TASK events
+func CannotExecuteTaskEvent() *EventBuilder[api.CannotExecuteTaskEvent] {
+	return newCannotExecuteTaskEvent(defaultGenerator())
+}
+
+// SignersRotatedEvent returns a builder of SIGNERS_ROTATED events
+func SignersRotatedEvent() *EventBuilder[api.SignersRotatedEvent] {
+	return newSignersRotatedEvent(defaultGenerator())
+}
+
+// ITSLinkTokenStartedEvent returns a builder of ITS/LINK_TOKEN_STARTED events
+func ITSLinkTokenStartedEvent() *EventBuilder[api.ITSLinkTokenStartedEvent] {
+	return newITSLinkTokenStartedEvent(defaultGenerator())
+}
+
+// ITSTokenMetadataRegisteredEvent returns a builder of ITS/TOKEN_METADATA_REGISTERED events
+func ITSTokenMetadataRegisteredEvent() *EventBuilder[api.ITSTokenMetadataRegisteredEvent] {
+	return newITSTokenMetadataRegisteredEvent(defaultGenerator())
+}
+
+// ITSInterchainTokenDeploymentStartedEvent returns a builder of ITS/INTERCHAIN_TOKEN_DEPLOYMENT_STARTED events
+func ITSInterchainTokenDeploymentStartedEvent() *EventBuilder[api.ITSInterchainTokenDeploymentStartedEvent] {
+	return newITSInterchainTokenDeploymentStartedEvent(defaultGenerator())
+}
+
+// ITSInterchainTransferEvent returns a builder of ITS/INTERCHAIN_TRANSFER events
+func ITSInterchainTransferEvent() *EventBuilder[api.ITSInterchainTransferEvent] {
+	return newITSInterchainTransferEvent(defaultGenerator())
+}
+
+// AppInterchainTransferSentEvent returns a builder of APP/INTERCHAIN_TRANSFER_SENT events
+func AppInterchainTransferSentEvent() *EventBuilder[api.AppInterchainTransferSentEvent] {
+	return newAppInterchainTransferSentEvent(defaultGenerator())
+}
+
+// AppInterchainTransferReceivedEvent returns a builder of APP/INTERCHAIN_TRANSFER_RECEIVED events
+func AppInterchainTransferReceivedEvent() *EventBuilder[api.AppInterchainTransferReceivedEvent] {
+	return newAppInterchainTransferReceivedEvent(defaultGenerator())
+}
+
+// eventFactories builds a random event of each type. It's used by RandomEvent.
+var eventFactories = map[api.EventType]func(generator) api.Event{
+	api.EventTypeGasCredit: func(g generator) api.Event {
+		return newGasCreditEvent(g).Build()
+	},
+	api.EventTypeGasRefunded: func(g generator) api.Event {
+		return newGasRefundedEvent(g).Build()
+	},
+	api.EventTypeCall: func(g generator) api.Event {
+		return newCallEvent(g).Build()
+	},
+	api.EventTypeMessageApproved: func(g generator) api.Event {
+		return newMessageApprovedEvent(g).Build()
+	},
+	api.EventTypeMessageExecuted: func(g generator) api.Event {
+		return newMessageExecutedEvent(g).Build()
+	},
+	api.EventTypeMessageExecutedV2: func(g generator) api.Event {
+		return newMessageExecutedEventV2(g).Build()
+	},
+	api.EventTypeCannotExecuteMessage: func(g generator) api.Event {
+		return newCannotExecuteMessageEvent(g).Build()
+	},
+	api.EventTypeCannotExecuteMessageV2: func(g generator) api.Event {
+		return newCannotExecuteMessageEventV2(g).Build()
+	},
+	api.EventTypeCannotRouteMessage: func(g generator) api.Event {
+		return newCannotRouteMessageEvent(g).Build()
+	},
+	api.EventTypeCannotExecuteTask: func(g generator) api.Event {
+		return newCannotExecuteTaskEvent(g).Build()
+	},
+	api.EventTypeSignersRotated: func(g generator) api.Event {
+		return newSignersRotatedEvent(g).Build()
+	},
+	api.EventTypeITSLinkTokenStarted: func(g generator) api.Event {
+		return newITSLinkTokenStartedEvent(g).Build()
+	},
+	api.EventTypeITSTokenMetadataRegistered: func(g generator) api.Event {
+		return newITSTokenMetadataRegisteredEvent(g).Build()
+	},
+	api.EventTypeITSInterchainTokenDeploymentStarted: func(g generator) api.Event {
+		return newITSInterchainTokenDeploymentStartedEvent(g).Build()
+	},
+	api.EventTypeITSInterchainTransfer: func(g generator) api.Event {
+		return newITSInterchainTransferEvent(g).Build()
+	},
+	api.EventTypeAppInterchainTransferSent: func(g generator) api.Event {
+		return newAppInterchainTransferSentEvent(g).Build()
+	},
+	api.EventTypeAppInterchainTransferReceived: func(g generator) api.Event {
+		return newAppInterchainTransferReceivedEvent(g).Build()
+	},
+}
+
+func newGasCreditEvent(g generator) *EventBuilder[api.GasCreditEvent] {
+	return newEventBuilder(api.GasCreditEvent{
+		EventID:       g.eventID(),
+		Meta:          g.eventMetadata(),
+		MessageID:     g.messageID(),
+		RefundAddress: g.address(),
+		Payment:       g.unsignedToken(),
+	}, (*api.Event).FromGasCreditEvent)
+}
+
+func newGasRefundedEvent(g generator) *EventBuilder[api.GasRefundedEvent] {
+	return newEventBuilder(api.GasRefundedEvent{
+		EventID:          g.eventID(),
+		Meta:             g.eventMetadata(),
+		MessageID:        g.messageID(),
+		RecipientAddress: g.address(),
+		RefundedAmount:   g.unsignedToken(),
+		Cost:             g.tokenCost(),
+	}, (*api.Event).FromGasRefundedEvent)
+}
+
+func newCallEvent(g generator) *EventBuilder[api.CallEvent] {
+	sourceChain := g.chain()
+	message, payload := g.message(sourceChain)
+	meta := g.eventMetadata()
+
+	return newEventBuilder(api.CallEvent{
+		EventID: g.eventID(),
+		Meta: &api.CallEventMetadata{
+			TxID:      meta.TxID,
+			Timestamp: meta.Timestamp,
+		},
+		Message:          message,
+		DestinationChain: g.otherChain(sourceChain),
+		Payload:          payload,
+	}, (*api.Event).FromCallEvent)
+}
+
+func newMessageApprovedEvent(g generator) *EventBuilder[api.MessageApprovedEvent] {
+	message, _ := g.message(g.chain())
+	meta := g.eventMetadata()
+
+	return newEventBuilder(api.MessageApprovedEvent{
+		EventID: g.eventID(),
+		Meta: &api.MessageApprovedEventMetadata{
+			TxID:      meta.TxID,
+			Timestamp: meta.Timestamp,
+		},
+		Message: message,
+		Cost:    g.tokenCost(),
+	}, (*api.Event).FromMessageApprovedEvent)
+}
+
+func newMessageExecutedEvent(g generator) *EventBuilder[api.MessageExecutedEvent] {
+	meta := g.eventMetadata()
+
+	return newEventBuilder(api.MessageExecutedEvent{
+		EventID: g.eventID(),
+		Meta: &api.MessageExecutedEventMetadata{
+			TxID:      meta.TxID,
+			Timestamp: meta.Timestamp,
+		},
+		MessageID:   g.messageID(),
+		SourceChain: g.chain(),
+		Status:      api.MessageExecutionStatusSuccessful,
+		Cost:        g.tokenCost(),
+	}, (*api.Event).FromMessageExecutedEvent)
+}
+
+func newMessageExecutedEventV2(g generator) *EventBuilder[api.MessageExecutedEventV2] {
+	meta := g.eventMetadata()
+
+	return newEventBuilder(api.MessageExecutedEventV2{
+		EventID: g.eventID(),
+		Meta: &api.MessageExecutedEventMetadata{
+			TxID:      meta.TxID,
+			Timestamp: meta.Timestamp,
+		},
+		CrossChainID: api.CrossChainID{
+			SourceChain: g.chain(),
+			MessageID:   g.messageID(),
+		},
+		Cost: g.tokenCost(),
+	}, (*api.Event).FromMessageExecutedEventV2)
+}
+
+func newCannotExecuteMessageEvent(g generator) *EventBuilder[api.CannotExecuteMessageEvent] {
+	return newEventBuilder(api.CannotExecuteMessageEvent{
+		EventID:    g.eventID(),
+		TaskItemID: g.uuid(),
+		Reason:     api.CannotExecuteMessageReasonError,
+		Details:    "execution failed",
+	}, (*api.Event).FromCannotExecuteMessageEvent)
+}
+
+func newCannotExecuteMessageEventV2(g generator) *EventBuilder[api.CannotExecuteMessageEventV2] {
+	return newEventBuilder(api.CannotExecuteMessageEventV2{
+		EventID:     g.eventID(),
+		MessageID:   g.messageID(),
+		SourceChain: g.chain(),
+		Reason:      api.CannotExecuteMessageReasonError,
+		Details:     "execution failed",
+	}, (*api.Event).FromCannotExecuteMessageEventV2)
+}
+
+func newCannotRouteMessageEvent(g generator) *EventBuilder[api.CannotRouteMessageEvent] {
+	return newEventBuilder(api.CannotRouteMessageEvent{
+		EventID:   g.eventID(),
+		Meta:      g.eventMetadata(),
+		MessageID: g.messageID(),
+		Reason:    api.CannotRouteMessageReasonCustom,
+		Details:   "routing refused",
+	}, (*api.Event).FromCannotRouteMessageEvent)
+}
+
+func newCannotExecuteTaskEvent(g generator) *EventBuilder[api.CannotExecuteTaskEvent] {
+	return newEventBuilder(api.CannotExecuteTaskEvent{
+		EventID:    g.eventID(),
+		Meta:       g.eventMetadata(),
+		TaskItemID: g.uuid(),
+		Reason:     api.CannotExecuteTaskReasonError,
+		Details:    "task failed",
+	}, (*api.Event).FromCannotExecuteTaskEvent)
+}
+
+func newSignersRotatedEvent(g generator) *EventBuilder[api.SignersRotatedEvent] {
+	meta := g.eventMetadata()
+	epoch := g.r.Int63n(1_000)
+
+	return newEventBuilder(api.SignersRotatedEvent{
+		EventID: g.eventID(),
+		Meta: &api.SignersRotatedEventMetadata{
+			TxID:        meta.TxID,
+			Timestamp:   meta.Timestamp,
+			SignersHash: ptr(g.bytes(32)),
+			Epoch:       &epoch,
+		},
+		MessageID: g.messageID(),
+	}, (*api.Event).FromSignersRotatedEvent)
+}
+
+func newITSLinkTokenStartedEvent(g generator) *EventBuilder[api.ITSLinkTokenStartedEvent] {
+	return newEventBuilder(api.ITSLinkTokenStartedEvent{
+		EventID:                 g.eventID(),
+		Meta:                    g.eventMetadata(),
+		MessageID:               g.messageID(),
+		TokenID:                 g.hex(32),
+		DestinationChain:        g.chain(),
+		SourceTokenAddress:      g.bytes(20),
+		DestinationTokenAddress: g.bytes(20),
+		TokenManagerType:        api.TokenManagerLockUnlock,
+	}, (*api.Event).FromITSLinkTokenStartedEvent)
+}
+
+func newITSTokenMetadataRegisteredEvent(g generator) *EventBuilder[api.ITSTokenMetadataRegisteredEvent] {
+	return newEventBuilder(api.ITSTokenMetadataRegisteredEvent{
+		EventID:   g.eventID(),
+		Meta:      g.eventMetadata(),
+		MessageID: g.messageID(),
+		Address:   g.address(),
+		Decimals:  uint8(g.r.Intn(19)),
+	}, (*api.Event).FromITSTokenMetadataRegisteredEvent)
+}
+
+func newITSInterchainTokenDeploymentStartedEvent(g generator) *EventBuilder[api.ITSInterchainTokenDeploymentStartedEvent] {
+	return newEventBuilder(api.ITSInterchainTokenDeploymentStartedEvent{
+		EventID:          g.eventID(),
+		Meta:             g.eventMetadata(),
+		MessageID:        g.messageID(),
+		DestinationChain: g.chain(),
+		Token: api.InterchainTokenDefinition{
+			ID:       g.hex(32),
+			Name:     "Test Token",
+			Symbol:   "TEST",
+			Decimals: uint8(g.r.Intn(19)),
+		},
+	}, (*api.Event).FromITSInterchainTokenDeploymentStartedEvent)
+}
+
+func newITSInterchainTransferEvent(g generator) *EventBuilder[api.ITSInterchainTransferEvent] {
+	return newEventBuilder(api.ITSInterchainTransferEvent{
+		EventID:          g.eventID(),
+		Meta:             g.eventMetadata(),
+		MessageID:        g.messageID(),
+		DestinationChain: g.chain(),
+		TokenSpent: api.InterchainTransferTokenWithID{
+			TokenID: g.hex(32),
+			Amount:  g.amount(),
+		},
+		SourceAddress:      g.address(),
+		DestinationAddress: g.bytes(20),
+		DataHash:           Keccak256(g.payload()),
+	}, (*api.Event).FromITSInterchainTransferEvent)
+}
+
+func newAppInterchainTransferSentEvent(g generator) *EventBuilder[api.AppInterchainTransferSentEvent] {
+	return newEventBuilder(api.AppInterchainTransferSentEvent{
+		EventID:                    g.eventID(),
+		MessageID:                  g.messageID(),
+		DestinationChain:           g.chain(),
+		DestinationContractAddress: g.address(),
+		Sender:                     g.address(),
+		Recipient:                  g.bytes(20),
+		TokenSpent: api.InterchainTransferTokenWithAddress{
+			TokenAddress: g.address(),
+			Amount:       g.amount(),
+		},
+	}, (*api.Event).FromAppInterchainTransferSentEvent)
+}
+
+func newAppInterchainTransferReceivedEvent(g generator) *EventBuilder[api.AppInterchainTransferReceivedEvent] {
+	return newEventBuilder(api.AppInterchainTransferReceivedEvent{
+		EventID:       g.eventID(),
+		MessageID:     g.messageID(),
+		SourceChain:   g.chain(),
+		SourceAddress: g.address(),
+		Sender:        g.bytes(20),
+		Recipient:     g.address(),
+		TokenReceived: api.InterchainTransferTokenWithAddress{
+			TokenAddress: g.address(),
+			Amount:       g.amount(),
+		},
+	}, (*api.Event).FromAppInterchainTransferReceivedEvent)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,57 @@
+package apitest
+
+import (
+	"maps"
+	"math/rand"
+	"reflect"
+	"slices"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+var (
+	eventTypes = slices.Sorted(maps.Keys(eventFactories))
+	taskTypes  = slices.Sorted(maps.Keys(taskFactories))
+)
+
+// RandomEvent returns a valid event of a random type. The result is deterministic for a given source.
+func RandomEvent(r *rand.Rand) api.Event {
+	g := newGenerator(r)
+	return eventFactories[eventTypes[r.Intn(len(eventTypes))]](g)
+}
+
+// RandomTaskItem returns a task item of a random type. The result is deterministic for a given source.
+func RandomTaskItem(r *rand.Rand) api.TaskItem {
+	g := newGenerator(r)
+	return taskFactories[taskTypes[r.Intn(len(taskTypes))]](g)
+}
+
+// EventFromSeed returns RandomEvent for the seed. It's handy in fuzz targets that take an int64 seed.
+func EventFromSeed(seed int64) api.Event {
+	return RandomEvent(rand.New(rand.NewSource(seed)))
+}
+
+// TaskItemFromSeed returns RandomTaskItem for the seed. It's handy in fuzz targets that take an int64 seed.
+func TaskItemFromSeed(seed int64) api.TaskItem {
+	return RandomTaskItem(rand.New(rand.NewSource(seed)))
+}
+
+// QuickEvent is an api.Event that implements quick.Generator, so that it can be used as an argument of testing/quick properties
+type QuickEvent struct {
+	api.Event
+}
+
+// Generate implements quick.Generator
+func (QuickEvent) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(QuickEvent{RandomEvent(r)})
+}
+
+// QuickTaskItem is an api.TaskItem that implements quick.Generator, so that it can be used as an argument of testing/quick properties
+type QuickTaskItem struct {
+	api.TaskItem
+}
+
+// Generate implements quick.Generator
+func (QuickTaskItem) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(QuickTaskItem{RandomTaskItem(r)})
+}
@@ -0,0 +1,231 @@
+package apitest
+
+import (
+	"encoding/json"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+// TaskBuilder builds an api.TaskItem carrying a task of the variant T
+type TaskBuilder[T any] struct {
+	item   api.TaskItem
+	value  T
+	setter func(*api.Task, T) error
+}
+
+func newTaskBuilder[T any](g generator, taskType api.TaskType, value T, setter func(*api.Task, T) error) *TaskBuilder[T] {
+	return &TaskBuilder[T]{
+		item: api.TaskItem{
+			ID:        g.uuid(),
+			Chain:     g.chain(),
+			Timestamp: g.timestamp(),
+			Type:      taskType,
+		},
+		value:  value,
+		setter: setter,
+	}
+}
+
+// With applies overrides to the task
+func (b *TaskBuilder[T]) With(overrides ...func(*T)) *TaskBuilder[T] {
+	for _, override := range overrides {
+		override(&b.value)
+	}
+
+	return b
+}
+
+// WithItem applies overrides to the TaskItem envelope, e.g. to set ID, Chain or Meta.
+// TaskItem.Task is replaced with the built task.
+func (b *TaskBuilder[T]) WithItem(overrides ...func(*api.TaskItem)) *TaskBuilder[T] {
+	for _, override := range overrides {
+		override(&b.item)
+	}
+
+	return b
+}
+
+// Value returns the task variant as it's currently configured
+func (b *TaskBuilder[T]) Value() T {
+	return b.value
+}
+
+// Build returns the task wrapped into api.TaskItem
+func (b *TaskBuilder[T]) Build() api.TaskItem {
+	item := b.item
+	funcs.MustNoErr(b.setter(&item.Task, b.value))
+
+	return item
+}
+
+// ConstructProofTask returns a builder of CONSTRUCT_PROOF tasks
+func ConstructProofTask() *TaskBuilder[api.ConstructProofTask] {
+	return newConstructProofTask(defaultGenerator())
+}
+
+// ExecuteTask returns a builder of EXECUTE tasks. Message.PayloadHash is the keccak256 hash of Payload.
+func ExecuteTask() *TaskBuilder[api.ExecuteTask] {
+	return newExecuteTask(defaultGenerator())
+}
+
+// GatewayTransactionTask returns a builder of GATEWAY_TX tasks
+func GatewayTransactionTask() *TaskBuilder[api.GatewayTransactionTask] {
+	return newGatewayTransactionTask(defaultGenerator())
+}
+
+// ReactToWasmEventTask returns a builder of REACT_TO_WASM_EVENT tasks
+func ReactToWasmEventTask() *TaskBuilder[api.ReactToWasmEventTask] {
+	return newReactToWasmEventTask(defaultGenerator())
+}
+
+// RefundTask returns a builder of REFUND tasks
+func RefundTask() *TaskBuilder[api.RefundTask] {
+	return newRefundTask(defaultGenerator())
+}
+
+// VerifyTask returns a builder of VERIFY tasks. Message.PayloadHash is the keccak256 hash of Payload.
+func VerifyTask() *TaskBuilder[api.VerifyTask] {
+	return newVerifyTask(defaultGenerator())
+}
+
+// ReactToExpiredSigningSessionTask returns a builder of REACT_TO_EXPIRED_SIGNING_SESSION tasks
+func ReactToExpiredSigningSessionTask() *TaskBuilder[api.ReactToExpiredSigningSessionTask] {
+	return newReactToExpiredSigningSessionTask(defaultGenerator())
+}
+
+// ReactToRetriablePollTask returns a builder of REACT_TO_RETRIABLE_POLL tasks
+func ReactToRetriablePollTask() *TaskBuilder[api.ReactToRetriablePollTask] {
+	return newReactToRetriablePollTask(defaultGenerator())
+}
+
+// taskFactories builds a random task of each type. It's used by RandomTaskItem.
+var taskFactories = map[api.TaskType]func(generator) api.TaskItem{
+	api.TaskTypeConstructProof: func(g generator) api.TaskItem {
+		return newConstructProofTask(g).Build()
+	},
+	api.TaskTypeExecute: func(g generator) api.TaskItem {
+		return newExecuteTask(g).Build()
+	},
+	api.TaskTypeGatewayTransaction: func(g generator) api.TaskItem {
+		return newGatewayTransactionTask(g).Build()
+	},
+	api.TaskTypeReactToWasmEvent: func(g generator) api.TaskItem {
+		return newReactToWasmEventTask(g).Build()
+	},
+	api.TaskTypeRefund: func(g generator) api.TaskItem {
+		return newRefundTask(g).Build()
+	},
+	api.TaskTypeVerify: func(g generator) api.TaskItem {
+		return newVerifyTask(g).Build()
+	},
+	api.TaskTypeReactToExpiredSigningSession: func(g generator) api.TaskItem {
+		return newReactToExpiredSigningSessionTask(g).Build()
+	},
+	api.TaskTypeReactToRetriablePoll: func(g generator) api.TaskItem {
+		return newReactToRetriablePollTask(g).Build()
+	},
+}
+
+func newConstructProofTask(g generator) *TaskBuilder[api.ConstructProofTask] {
+	message, payload := g.message(g.chain())
+
+	return newTaskBuilder(g, api.TaskTypeConstructProof, api.ConstructProofTask{
+		Message: message,
+		Payload: payload,
+	}, (*api.Task).FromConstructProofTask)
+}
+
+func newExecuteTask(g generator) *TaskBuilder[api.ExecuteTask] {
+	message, payload := g.message(g.chain())
+
+	return newTaskBuilder(g, api.TaskTypeExecute, api.ExecuteTask{
+		Message: message,
+		Payload: payload,
+		AvailableGasBalance: api.Token{
+			Amount: g.amount(),
+		},
+	}, (*api.Task).FromExecuteTask)
+}
+
+func newGatewayTransactionTask(g generator) *TaskBuilder[api.GatewayTransactionTask] {
+	return newTaskBuilder(g, api.TaskTypeGatewayTransaction, api.GatewayTransactionTask{
+		ExecuteData: g.payload(),
+	}, (*api.Task).FromGatewayTransactionTask)
+}
+
+func newReactToWasmEventTask(g generator) *TaskBuilder[api.ReactToWasmEventTask] {
+	message, _ := g.message(g.chain())
+	// contracts emit statuses in snake_case, unlike the API's VerificationStatus
+	event := funcs.Must(api.EncodeWasmEvent("wasm-quorum_reached", struct {
+		Content api.WasmMessage            `wasm:"content"`
+		Status  api.WasmVerificationStatus `wasm:"status"`
+		PollID  uint64                     `wasm:"poll_id"`
+	}{
+		Content: api.WasmMessageFromMessage(message, g.chain()),
+		Status:  "succeeded_on_source_chain",
+		PollID:  g.r.Uint64() % 1_000_000,
+	}))
+
+	return newTaskBuilder(g, api.TaskTypeReactToWasmEvent, api.ReactToWasmEventTask{
+		Height: g.r.Int63n(100_000_000),
+		Event:  event,
+	}, (*api.Task).FromReactToWasmEventTask)
+}
+
+func newRefundTask(g generator) *TaskBuilder[api.RefundTask] {
+	message, _ := g.message(g.chain())
+
+	return newTaskBuilder(g, api.TaskTypeRefund, api.RefundTask{
+		Message:                message,
+		RefundRecipientAddress: g.address(),
+		RemainingGasBalance:    g.unsignedToken(),
+	}, (*api.Task).FromRefundTask)
+}
+
+func newVerifyTask(g generator) *TaskBuilder[api.VerifyTask] {
+	sourceChain := g.chain()
+	message, payload := g.message(sourceChain)
+
+	return newTaskBuilder(g, api.TaskTypeVerify, api.VerifyTask{
+		Message:          message,
+		DestinationChain: g.otherChain(sourceChain),
+		Payload:          payload,
+	}, (*api.Task).FromVerifyTask)
+}
+
+func newReactToExpiredSigningSessionTask(g generator) *TaskBuilder[api.ReactToExpiredSigningSessionTask] {
+	message, _ := g.message(g.chain())
+
+	return newTaskBuilder(g, api.TaskTypeReactToExpiredSigningSession, api.ReactToExpiredSigningSessionTask{
+		SessionID:              g.r.Uint64() % 1_000_000,
+		BroadcastID:            g.uuid(),
+		InvokedContractAddress: g.wasmContractAddress(),
+		RequestPayload: funcs.Must(api.WasmRequestFromObject(api.ProverConstructProof{
+			ConstructProof: []api.WasmCrossChainID{api.WasmCrossChainIDFromCrossChainID(api.CrossChainID{
+				SourceChain: message.SourceChain,
+				MessageID:   message.MessageID,
+			})},
+		})),
+	}, (*api.Task).FromReactToExpiredSigningSessionTask)
+}
+
+func newReactToRetriablePollTask(g generator) *TaskBuilder[api.ReactToRetriablePollTask] {
+	message, _ := g.message(g.chain())
+	wasmMessage := api.WasmMessageFromMessage(message, g.chain())
+
+	return newTaskBuilder(g, api.TaskTypeReactToRetriablePoll, api.ReactToRetriablePollTask{
+		PollID:                 g.r.Uint64() % 1_000_000,
+		BroadcastID:            g.uuid(),
+		InvokedContractAddress: g.wasmContractAddress(),
+		RequestPayload: funcs.Must(api.WasmRequestFromObject(api.GatewayVerifyMessages{
+			VerifyMessages: []api.WasmMessage{wasmMessage},
+		})),
+		QuorumReachedEvents: []api.QuorumReachedEvent{
+			{
+				Status:  api.VerificationStatusSucceededOnSourceChain,
+				Content: funcs.Must(json.Marshal(wasmMessage)),
+			},
+		},
+	}, (*api.Task).FromReactToRetriablePollTask)
+}
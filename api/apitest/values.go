@@ -0,0 +1,135 @@
+// Package apitest provides fixtures for tests of code built on top of the api package.
+// Builders fill all required fields with random valid values, so that built events pass api.Event.Validate,
+// and allow overriding any field.
+package apitest
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+var chains = []string{"ethereum", "avalanche", "xrpl", "stellar", "sui", "solana"}
+
+// Keccak256 returns keccak256 hash of the data, e.g. to compute api.Message.PayloadHash
+func Keccak256(data []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// generator produces random valid values. It's deterministic for a given source.
+type generator struct {
+	r *rand.Rand
+}
+
+func newGenerator(r *rand.Rand) generator {
+	return generator{r: r}
+}
+
+// defaultGenerator returns a generator with its own source, so that builders are safe to use from parallel tests
+func defaultGenerator() generator {
+	return newGenerator(rand.New(rand.NewSource(rand.Int63())))
+}
+
+func (g generator) uuid() uuid.UUID {
+	return funcs.Must(uuid.NewRandomFromReader(g.r))
+}
+
+func (g generator) eventID() string {
+	return g.uuid().String()
+}
+
+func (g generator) bytes(n int) []byte {
+	b := make([]byte, n)
+	_, _ = g.r.Read(b)
+	return b
+}
+
+func (g generator) hex(n int) string {
+	return "0x" + hex.EncodeToString(g.bytes(n))
+}
+
+func (g generator) address() api.Address {
+	return g.hex(20)
+}
+
+func (g generator) chain() string {
+	return chains[g.r.Intn(len(chains))]
+}
+
+func (g generator) otherChain(chain string) string {
+	for {
+		if other := g.chain(); other != chain {
+			return other
+		}
+	}
+}
+
+func (g generator) messageID() string {
+	return fmt.Sprintf("%s-%d", g.hex(32), g.r.Intn(100))
+}
+
+func (g generator) amount() api.UnsignedBigInt {
+	return strconv.FormatUint(g.r.Uint64()%1_000_000_000_000_000_000+1, 10)
+}
+
+func (g generator) timestamp() time.Time {
+	return time.Unix(1_700_000_000+g.r.Int63n(100_000_000), 0).UTC()
+}
+
+func (g generator) unsignedToken() api.UnsignedToken {
+	return api.UnsignedToken{
+		Amount: g.amount(),
+	}
+}
+
+func (g generator) tokenCost() api.Cost {
+	return api.CostFromToken(g.unsignedToken())
+}
+
+func (g generator) payload() []byte {
+	return g.bytes(32 + g.r.Intn(96))
+}
+
+// message returns a message and the payload its PayloadHash is computed from
+func (g generator) message(sourceChain string) (api.Message, []byte) {
+	payload := g.payload()
+
+	return api.Message{
+		MessageID:          g.messageID(),
+		SourceChain:        sourceChain,
+		SourceAddress:      g.address(),
+		DestinationAddress: g.address(),
+		PayloadHash:        Keccak256(payload),
+	}, payload
+}
+
+func (g generator) eventMetadata() *api.EventMetadata {
+	txID := g.hex(32)
+	timestamp := g.timestamp()
+
+	return &api.EventMetadata{
+		TxID:      &txID,
+		Timestamp: &timestamp,
+	}
+}
+
+func (g generator) wasmContractAddress() api.WasmContractAddress {
+	const charset = "acdefghjklmnpqrstuvwxyz023456789"
+
+	address := make([]byte, 58)
+	for i := range address {
+		address[i] = charset[g.r.Intn(len(charset))]
+	}
+
+	return "axelar1" + string(address)
+}
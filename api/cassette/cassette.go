@@ -0,0 +1,118 @@
+// Package cassette records HTTP interactions of the generated client to a JSONL file and replays them back.
+// Recordings of production incidents can then be turned into deterministic regression tests of relayer logic.
+//
+// A cassette holds one Interaction per line, in the order requests were sent. Streams are recorded once they are closed.
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"unicode/utf8"
+)
+
+// Redacted replaces values of redacted headers
+const Redacted = "REDACTED"
+
+// DefaultRedactedHeaders are headers that carry credentials or client certificates forwarded by mTLS terminating proxies.
+// Their values are never written to a cassette.
+var DefaultRedactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Api-Key",
+	"X-Client-Cert",
+	"X-Forwarded-Client-Cert",
+	"X-Ssl-Client-Cert",
+}
+
+const bodyEncodingBase64 = "base64"
+
+// Interaction is a recorded request/response pair
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitzero"`
+}
+
+// Response is a recorded HTTP response
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitzero"`
+}
+
+// Body is a recorded HTTP body. UTF-8 bodies are kept as is to keep cassettes readable, others are base64 encoded.
+type Body struct {
+	Data     string `json:"data"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+func newBody(data []byte) Body {
+	if utf8.Valid(data) {
+		return Body{Data: string(data)}
+	}
+
+	return Body{Data: base64.StdEncoding.EncodeToString(data), Encoding: bodyEncodingBase64}
+}
+
+// Bytes returns the decoded body
+func (b Body) Bytes() ([]byte, error) {
+	if b.Encoding == bodyEncodingBase64 {
+		return base64.StdEncoding.DecodeString(b.Data)
+	}
+
+	return []byte(b.Data), nil
+}
+
+// readBody reads the body and replaces it with an equivalent one, so that it can be read again
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	closeErr := (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, closeErr
+}
+
+// canonicalBody compacts JSON bodies, so that formatting doesn't affect matching
+func canonicalBody(data []byte) []byte {
+	var buf bytes.Buffer
+	if json.Valid(data) && json.Compact(&buf, data) == nil {
+		return buf.Bytes()
+	}
+
+	return data
+}
+
+func redact(header http.Header, redacted map[string]struct{}) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+
+	result := header.Clone()
+	for key := range result {
+		if _, ok := redacted[http.CanonicalHeaderKey(key)]; ok {
+			result[key] = []string{Redacted}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,178 @@
+package cassette_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/cassette"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/memserver"
+)
+
+const secret = "Bearer secret-token"
+
+func withAuth(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", secret)
+	req.Header.Set("X-Client-Cert", "-----BEGIN CERTIFICATE-----")
+	return nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	server := memserver.New(memserver.WithChains("ethereum"))
+	tasks := []api.TaskItem{
+		apitest.ExecuteTask().WithItem(func(item *api.TaskItem) { item.Chain = "ethereum" }).Build(),
+		apitest.RefundTask().WithItem(func(item *api.TaskItem) { item.Chain = "ethereum" }).Build(),
+	}
+	for _, task := range tasks {
+		require.NoError(t, server.EnqueueTask(task))
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, server)
+	upstream := httptest.NewServer(router)
+
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+	payload := []byte{0xff, 0x00, 0xfe}
+	event := apitest.CallEvent().Build()
+
+	// run is the relayer logic under test, it records responses it got
+	run := func(client api.ClientWithResponsesInterface) (first, second []api.TaskItem, hash string, published api.PublishEventsResult) {
+		ctx := context.Background()
+
+		res := funcs.Must(client.GetTasksWithResponse(ctx, "ethereum", &api.GetTasksParams{Limit: ptr(1)}))
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		first = res.JSON200.Tasks
+
+		res = funcs.Must(client.GetTasksWithResponse(ctx, "ethereum", &api.GetTasksParams{After: &first[0].ID}))
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		second = res.JSON200.Tasks
+
+		stored := funcs.Must(client.StorePayloadWithBodyWithResponse(ctx, "application/octet-stream", bytes.NewReader(payload)))
+		require.Equal(t, http.StatusOK, stored.StatusCode())
+
+		events := funcs.Must(client.PublishEventsWithResponse(ctx, "ethereum", api.PublishEventsRequest{Events: []api.Event{event}}))
+		require.Equal(t, http.StatusOK, events.StatusCode())
+
+		return first, second, stored.JSON200.Keccak256, *events.JSON200
+	}
+
+	recorder := funcs.Must(cassette.NewFileRecorder(http.DefaultClient, path))
+	recordedFirst, recordedSecond, recordedHash, recordedPublished := run(
+		funcs.Must(api.NewClientWithResponses(upstream.URL, api.WithHTTPClient(recorder), api.WithRequestEditorFn(withAuth))),
+	)
+	require.NoError(t, recorder.Close())
+	upstream.Close()
+
+	t.Run("should redact credentials", func(t *testing.T) {
+		replayer := funcs.Must(cassette.NewFileReplayer(path))
+		assert.Equal(t, 4, replayer.Remaining())
+
+		raw := string(funcs.Must(os.ReadFile(path)))
+		assert.NotContains(t, raw, "secret-token")
+		assert.NotContains(t, raw, "BEGIN CERTIFICATE")
+		assert.Contains(t, raw, cassette.Redacted)
+	})
+
+	t.Run("should replay recorded responses without the upstream", func(t *testing.T) {
+		replayer := funcs.Must(cassette.NewFileReplayer(path))
+		client := funcs.Must(api.NewClientWithResponses("http://replay.invalid", api.WithHTTPClient(replayer)))
+
+		first, second, hash, published := run(client)
+		assert.Equal(t, recordedFirst[0].ID, first[0].ID)
+		assert.Equal(t, tasks[0].ID, first[0].ID)
+		assert.Equal(t, recordedSecond[0].ID, second[0].ID)
+		assert.Equal(t, tasks[1].ID, second[0].ID)
+		assert.Equal(t, recordedHash, hash)
+		assert.Equal(t, "0x"+hex.EncodeToString(apitest.Keccak256(payload)), hash)
+		assert.Len(t, published.Results, len(recordedPublished.Results))
+		assert.Zero(t, replayer.Remaining())
+
+		_, err := client.GetTasksWithResponse(context.Background(), "ethereum", &api.GetTasksParams{Limit: ptr(1)})
+		assert.ErrorIs(t, err, cassette.ErrNoInteraction, "every interaction must be served once")
+	})
+
+	t.Run("should not match a different request", func(t *testing.T) {
+		replayer := funcs.Must(cassette.NewFileReplayer(path))
+		client := funcs.Must(api.NewClientWithResponses("http://replay.invalid", api.WithHTTPClient(replayer)))
+
+		_, err := client.GetTasksWithResponse(context.Background(), "ethereum", &api.GetTasksParams{Limit: ptr(2)})
+		assert.ErrorIs(t, err, cassette.ErrNoInteraction)
+
+		_, err = client.GetTaskWithResponse(context.Background(), "ethereum", uuid.New())
+		assert.ErrorIs(t, err, cassette.ErrNoInteraction)
+
+		other := apitest.CallEvent().Build()
+		_, err = client.PublishEventsWithResponse(context.Background(), "ethereum", api.PublishEventsRequest{Events: []api.Event{other}})
+		assert.ErrorIs(t, err, cassette.ErrNoInteraction)
+	})
+}
+
+func TestRecordAndReplay_Stream(t *testing.T) {
+	server := memserver.New(memserver.WithChains("ethereum"))
+	task := apitest.ExecuteTask().WithItem(func(item *api.TaskItem) { item.Chain = "ethereum" }).Build()
+	require.NoError(t, server.EnqueueTask(task))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, server)
+	upstream := httptest.NewServer(router)
+	t.Cleanup(upstream.Close)
+
+	errStop := errors.New("stop")
+	consumeFirst := func(client api.ClientInterface) api.TaskItem {
+		var received api.TaskItem
+		err := api.ConsumeTasks(context.Background(), client, "ethereum", func(_ context.Context, task api.TaskItem) error {
+			received = task
+			return errStop
+		})
+		require.ErrorIs(t, err, errStop)
+
+		return received
+	}
+
+	var buf bytes.Buffer
+	recorder := cassette.NewRecorder(http.DefaultClient, &buf)
+
+	done := make(chan api.TaskItem)
+	go func() {
+		done <- consumeFirst(funcs.Must(api.NewClient(upstream.URL, api.WithHTTPClient(recorder))))
+	}()
+
+	select {
+	case received := <-done:
+		assert.Equal(t, task.ID, received.ID, "stream must be passed through while it's open")
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "recorder blocked on the stream")
+	}
+	assert.Contains(t, buf.String(), task.ID.String(), "stream must be recorded once it's closed")
+
+	replayer := funcs.Must(cassette.NewReplayer(&buf))
+	replayed := consumeFirst(funcs.Must(api.NewClient("http://replay.invalid", api.WithHTTPClient(replayer))))
+	assert.Equal(t, task.ID, replayed.ID)
+	assert.Zero(t, replayer.Remaining())
+}
+
+func TestNewReplayer_InvalidCassette(t *testing.T) {
+	_, err := cassette.NewReplayer(bytes.NewBufferString("{}\nnot json\n"))
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,170 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+var _ api.HttpRequestDoer = (*Recorder)(nil)
+
+// Recorder is an api.HttpRequestDoer that sends requests with the wrapped doer and appends every interaction to a cassette.
+// It's safe for concurrent use.
+type Recorder struct {
+	doer     api.HttpRequestDoer
+	redacted map[string]struct{}
+
+	mu      sync.Mutex
+	encoder *json.Encoder
+	closer  io.Closer
+}
+
+// Option configures Recorder
+type Option func(*Recorder)
+
+// WithRedactedHeaders redacts the headers in addition to DefaultRedactedHeaders
+func WithRedactedHeaders(headers ...string) Option {
+	return func(r *Recorder) {
+		for _, header := range headers {
+			r.redacted[http.CanonicalHeaderKey(header)] = struct{}{}
+		}
+	}
+}
+
+// NewRecorder creates a Recorder writing interactions to w
+func NewRecorder(doer api.HttpRequestDoer, w io.Writer, opts ...Option) *Recorder {
+	r := &Recorder{
+		doer:     doer,
+		redacted: make(map[string]struct{}, len(DefaultRedactedHeaders)),
+		encoder:  json.NewEncoder(w),
+	}
+
+	for _, header := range DefaultRedactedHeaders {
+		r.redacted[http.CanonicalHeaderKey(header)] = struct{}{}
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// NewFileRecorder creates a Recorder appending interactions to the cassette file at path. Call Close to close the file.
+func NewFileRecorder(doer api.HttpRequestDoer, path string, opts ...Option) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cassette: %w", err)
+	}
+
+	r := NewRecorder(doer, f, opts...)
+	r.closer = f
+
+	return r, nil
+}
+
+// Do implements api.HttpRequestDoer. Transport errors are returned as is and not recorded.
+// Streaming responses, e.g. of StreamTasks, are passed through as they're read and recorded once their body is closed,
+// with the part of the stream that was read.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	res, err := r.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	record := func(resBody []byte) error {
+		return r.record(Interaction{
+			Request: Request{
+				Method: req.Method,
+				URL:    req.URL.String(),
+				Header: redact(req.Header, r.redacted),
+				Body:   newBody(reqBody),
+			},
+			Response: Response{
+				StatusCode: res.StatusCode,
+				Header:     redact(res.Header, r.redacted),
+				Body:       newBody(resBody),
+			},
+		})
+	}
+
+	if isStream(res) {
+		res.Body = &streamBody{ReadCloser: res.Body, record: record}
+		return res, nil
+	}
+
+	resBody, err := readBody(&res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if err := record(resBody); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Recorder) record(interaction Interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.encoder.Encode(interaction); err != nil {
+		return fmt.Errorf("failed to record interaction: %w", err)
+	}
+
+	return nil
+}
+
+// streamBody copies what's read from a streaming response body and records it when the body is closed,
+// since reading the body up front would block until the server ends the stream
+type streamBody struct {
+	io.ReadCloser
+	record func(body []byte) error
+
+	buf  bytes.Buffer
+	once sync.Once
+}
+
+func (b *streamBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.buf.Write(p[:n])
+
+	return n, err
+}
+
+func (b *streamBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		err = errors.Join(err, b.record(b.buf.Bytes()))
+	})
+
+	return err
+}
+
+func isStream(res *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	return err == nil && mediaType == "text/event-stream"
+}
+
+// Close closes the cassette file if the Recorder was created with NewFileRecorder
+func (r *Recorder) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+
+	return r.closer.Close()
+}
@@ -0,0 +1,162 @@
+package cassette
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+// ErrNoInteraction is an error when no unused interaction of the cassette matches a request
+var ErrNoInteraction = errors.New("no matching interaction in cassette")
+
+var _ api.HttpRequestDoer = (*Replayer)(nil)
+
+// Replayer is an api.HttpRequestDoer that serves responses from a cassette instead of sending requests.
+// A request matches an interaction with the same method, path with query and body; JSON bodies are compared regardless of formatting.
+// Every interaction is served once, and matching interactions are served in the recorded order,
+// so that repeated identical requests, e.g. polling of tasks, get the same sequence of responses as when recorded.
+// It's safe for concurrent use.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayer creates a Replayer of the cassette read from r
+func NewReplayer(r io.Reader) (*Replayer, error) {
+	var interactions []Interaction
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var interaction Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return nil, fmt.Errorf("failed to parse interaction on line %d: %w", line, err)
+		}
+		interactions = append(interactions, interaction)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	return &Replayer{
+		interactions: interactions,
+		used:         make([]bool, len(interactions)),
+	}, nil
+}
+
+// NewFileReplayer creates a Replayer of the cassette file at path
+func NewFileReplayer(path string) (*Replayer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	return NewReplayer(f)
+}
+
+// Do implements api.HttpRequestDoer
+func (r *Replayer) Do(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] {
+			continue
+		}
+
+		matches, err := interaction.Request.matches(req, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to match interaction %d: %w", i, err)
+		}
+
+		if matches {
+			r.used[i] = true
+			return interaction.Response.toHTTP(req)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+}
+
+// Remaining returns the number of interactions that haven't been served yet.
+// Tests can assert it's zero to make sure the code under test sent every recorded request.
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	remaining := 0
+	for _, used := range r.used {
+		if !used {
+			remaining++
+		}
+	}
+
+	return remaining
+}
+
+func (req Request) matches(actual *http.Request, actualBody []byte) (bool, error) {
+	if req.Method != actual.Method {
+		return false, nil
+	}
+
+	recordedURL, err := url.Parse(req.URL)
+	if err != nil {
+		return false, err
+	}
+
+	if recordedURL.Path != actual.URL.Path || recordedURL.Query().Encode() != actual.URL.Query().Encode() {
+		return false, nil
+	}
+
+	body, err := req.Body.Bytes()
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(canonicalBody(body), canonicalBody(actualBody)), nil
+}
+
+func (res Response) toHTTP(req *http.Request) (*http.Response, error) {
+	body, err := res.Body.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response body: %w", err)
+	}
+
+	header := res.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)),
+		StatusCode:    res.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+// MaxBatchGetTaskIDs is the maximum number of IDs BatchGetTasks accepts at once
+const MaxBatchGetTaskIDs = 100
+
+// GetTasksByID retrieves the tasks of the chain with the given IDs with as few BatchGetTasks calls as possible,
+// splitting the IDs into chunks of MaxBatchGetTaskIDs. Found tasks and missing IDs keep the order of ids, duplicates are returned once.
+func GetTasksByID(ctx context.Context, client ClientWithResponsesInterface, chain Chain, ids []TaskItemID) (BatchGetTasksResult, error) {
+	result := BatchGetTasksResult{Tasks: []TaskItem{}, Missing: []TaskItemID{}}
+
+	seen := make(map[TaskItemID]bool, len(ids))
+	unique := slices.DeleteFunc(slices.Clone(ids), func(id TaskItemID) bool {
+		if seen[id] {
+			return true
+		}
+		seen[id] = true
+		return false
+	})
+
+	for chunk := range slices.Chunk(unique, MaxBatchGetTaskIDs) {
+		res, err := client.BatchGetTasksWithResponse(ctx, chain, BatchGetTasksRequest{Ids: chunk})
+		if err != nil {
+			return BatchGetTasksResult{}, fmt.Errorf("failed to get tasks of chain %s: %w", chain, err)
+		}
+
+		if res.StatusCode() != http.StatusOK || res.JSON200 == nil {
+			return BatchGetTasksResult{}, fmt.Errorf("failed to get tasks of chain %s: unexpected status %s: %s", chain, res.Status(),
+				errorResponseMessage(funcs.FirstNonNil(res.JSON400, res.JSON404, res.JSON500), res.Body))
+		}
+
+		result.Tasks = append(result.Tasks, res.JSON200.Tasks...)
+		result.Missing = append(result.Missing, res.JSON200.Missing...)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,96 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/memserver"
+)
+
+// countingServer counts BatchGetTasks calls of a memserver.Server
+type countingServer struct {
+	*memserver.Server
+	batches int
+}
+
+func (s *countingServer) BatchGetTasks(c *gin.Context, chain api.Chain) {
+	s.batches++
+	s.Server.BatchGetTasks(c, chain)
+}
+
+func newBatchGetTestServer(t *testing.T) (*countingServer, string) {
+	t.Helper()
+
+	server := &countingServer{Server: memserver.New(memserver.WithChains("ethereum"))}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, server)
+
+	httpServer := httptest.NewServer(router)
+	t.Cleanup(httpServer.Close)
+
+	return server, httpServer.URL
+}
+
+func TestGetTasksByID(t *testing.T) {
+	server, url := newBatchGetTestServer(t)
+	client := funcs.Must(api.NewClientWithResponses(url))
+
+	var ids []api.TaskItemID
+	for range 250 {
+		task := apitest.ExecuteTask().WithItem(func(item *api.TaskItem) { item.Chain = "ethereum" }).Build()
+		require.NoError(t, server.EnqueueTask(task))
+		ids = append(ids, task.ID)
+	}
+
+	missing := uuid.New()
+	requested := append([]api.TaskItemID{missing}, ids...)
+	requested = append(requested, ids[0], missing)
+
+	result := funcs.Must(api.GetTasksByID(context.Background(), client, "ethereum", requested))
+	assert.Equal(t, 3, server.batches, "251 unique IDs must be fetched in 3 chunks")
+	assert.Equal(t, []api.TaskItemID{missing}, result.Missing)
+	require.Len(t, result.Tasks, len(ids))
+	for i, task := range result.Tasks {
+		assert.Equal(t, ids[i], task.ID)
+	}
+
+	t.Run("should fail for unknown chains", func(t *testing.T) {
+		_, err := api.GetTasksByID(context.Background(), client, "unknown", ids)
+		assert.ErrorContains(t, err, "404")
+	})
+
+	t.Run("should not call the API without IDs", func(t *testing.T) {
+		server.batches = 0
+		result := funcs.Must(api.GetTasksByID(context.Background(), client, "ethereum", nil))
+		assert.Empty(t, result.Tasks)
+		assert.Zero(t, server.batches)
+	})
+}
+
+func TestRegisterServer_CustomMethod(t *testing.T) {
+	server, url := newBatchGetTestServer(t)
+
+	for _, path := range []string{"/chains/ethereum/tasksX", "/chains/ethereum/tasks:batchGetX", "/chains/ethereum/tasks:other"} {
+		res := funcs.Must(http.Post(url+path, "application/json", strings.NewReader(`{"ids":["`+uuid.NewString()+`"]}`)))
+		_ = res.Body.Close()
+		assert.Equal(t, http.StatusNotFound, res.StatusCode, path)
+	}
+	assert.Zero(t, server.batches)
+
+	res := funcs.Must(http.Post(url+"/chains/ethereum/tasks:batchGet", "application/json", strings.NewReader(`{"ids":["`+uuid.NewString()+`"]}`)))
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// fetchTimeout bounds requests shared by concurrent lookups, which don't stop when a single caller gives up
+const fetchTimeout = 30 * time.Second
+
+// ErrChainNotFound is an error when the chain isn't integrated with Amplifier
+var ErrChainNotFound = errors.New("chain not found")
+
+// ChainCache caches the chain registry, so that relayers can look up contracts of chains instead of hard-coding them.
+// The whole registry is fetched with GetChains and kept for the TTL. Chains missing from a fresh registry are fetched with GetChain,
+// so that chains integrated in the meantime are found without a refresh. Chains not found are remembered until the next refresh.
+// It's safe for concurrent use, and concurrent lookups share requests instead of waiting for each other.
+type ChainCache struct {
+	client ClientWithResponsesInterface
+	ttl    time.Duration
+	group  singleflight.Group
+
+	mu        sync.Mutex
+	chains    []ChainInfo
+	notFound  map[string]string
+	expiresAt time.Time
+}
+
+// NewChainCache creates a ChainCache keeping the registry for the given duration
+func NewChainCache(client ClientWithResponsesInterface, ttl time.Duration) *ChainCache {
+	return &ChainCache{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// Chains returns all chains of the registry in the order the API lists them
+func (c *ChainCache) Chains(ctx context.Context) ([]ChainInfo, error) {
+	chains, err := c.registry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(chains), nil
+}
+
+// Chain returns the chain with the given name. Unknown chains are returned as ErrChainNotFound.
+func (c *ChainCache) Chain(ctx context.Context, name string) (ChainInfo, error) {
+	chains, err := c.registry(ctx)
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	if i := slices.IndexFunc(chains, func(info ChainInfo) bool { return info.Name == name }); i >= 0 {
+		return chains[i], nil
+	}
+
+	c.mu.Lock()
+	message, notFound := c.notFound[name]
+	c.mu.Unlock()
+
+	if notFound {
+		return ChainInfo{}, fmt.Errorf("%w: %s", ErrChainNotFound, message)
+	}
+
+	chain, err := c.do(ctx, "chain/"+name, func(ctx context.Context) (any, error) {
+		return c.fetchChain(ctx, name)
+	})
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	return chain.(ChainInfo), nil
+}
+
+// Purge drops the cached registry and chains not found, so that the next lookup fetches them again
+func (c *ChainCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.chains = nil
+	c.notFound = nil
+	c.expiresAt = time.Time{}
+}
+
+// registry returns the cached registry, fetching it if it's expired. The returned slice must not be modified.
+func (c *ChainCache) registry(ctx context.Context) ([]ChainInfo, error) {
+	c.mu.Lock()
+	chains, fresh := c.chains, c.chains != nil && time.Now().Before(c.expiresAt)
+	c.mu.Unlock()
+
+	if fresh {
+		return chains, nil
+	}
+
+	result, err := c.do(ctx, "chains", func(ctx context.Context) (any, error) {
+		return c.fetchRegistry(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]ChainInfo), nil
+}
+
+// do shares fetch between concurrent callers with the same key. The fetch runs without the cancellation of the caller starting it,
+// so that callers giving up don't fail the others, and every caller stops waiting once its own context is done.
+func (c *ChainCache) do(ctx context.Context, key string, fetch func(ctx context.Context) (any, error)) (any, error) {
+	ch := c.group.DoChan(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+		defer cancel()
+
+		return fetch(ctx)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-ch:
+		return result.Val, result.Err
+	}
+}
+
+func (c *ChainCache) fetchRegistry(ctx context.Context) ([]ChainInfo, error) {
+	res, err := c.client.GetChainsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chains: %w", err)
+	}
+
+	if res.StatusCode() != http.StatusOK || res.JSON200 == nil {
+		return nil, fmt.Errorf("failed to get chains: unexpected status %s: %s", res.Status(),
+			errorResponseMessage(res.JSON500, res.Body))
+	}
+
+	chains := slices.Clip(res.JSON200.Chains)
+	if chains == nil {
+		chains = []ChainInfo{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.chains = chains
+	c.notFound = nil
+	c.expiresAt = time.Now().Add(c.ttl)
+
+	return chains, nil
+}
+
+func (c *ChainCache) fetchChain(ctx context.Context, name string) (ChainInfo, error) {
+	res, err := c.client.GetChainWithResponse(ctx, name)
+	if err != nil {
+		return ChainInfo{}, fmt.Errorf("failed to get chain %s: %w", name, err)
+	}
+
+	switch res.StatusCode() {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		message := errorResponseMessage(res.JSON404, res.Body)
+
+		c.mu.Lock()
+		if c.notFound == nil {
+			c.notFound = make(map[string]string)
+		}
+		c.notFound[name] = message
+		c.mu.Unlock()
+
+		return ChainInfo{}, fmt.Errorf("%w: %s", ErrChainNotFound, message)
+	default:
+		return ChainInfo{}, fmt.Errorf("failed to get chain %s: unexpected status %s: %s", name, res.Status(),
+			errorResponseMessage(res.JSON500, res.Body))
+	}
+
+	if res.JSON200 == nil {
+		return ChainInfo{}, fmt.Errorf("failed to get chain %s: empty response", name)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// the registry may have been refreshed with the chain in the meantime
+	if !slices.ContainsFunc(c.chains, func(info ChainInfo) bool { return info.Name == name }) {
+		c.chains = append(c.chains, res.JSON200.Chain)
+	}
+
+	return res.JSON200.Chain, nil
+}
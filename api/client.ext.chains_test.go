@@ -0,0 +1,191 @@
+package api_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/memserver"
+)
+
+var (
+	ethereumInfo = api.ChainInfo{
+		Name:           "ethereum",
+		AddressFormat:  api.AddressFormatEIP55,
+		Gateway:        "axelar1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq0",
+		VotingVerifier: "axelar1pppppppppppppppppppppppppppppppppppppppppppppppppppppppppp",
+		MultisigProver: "axelar1zzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzz",
+	}
+	solanaInfo = api.ChainInfo{
+		Name:           "solana",
+		AddressFormat:  api.AddressFormatBase58,
+		Gateway:        "axelar1rrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrr",
+		VotingVerifier: "axelar1ssssssssssssssssssssssssssssssssssssssssssssssssssssssssss",
+		MultisigProver: "axelar1tttttttttttttttttttttttttttttttttttttttttttttttttttttttttt",
+	}
+)
+
+// chainsServer counts GetChains and GetChain calls of a memserver.Server.
+// If held is set, GetChain announces itself on it and waits until release is closed.
+type chainsServer struct {
+	*memserver.Server
+	lists, gets atomic.Int32
+
+	held    chan struct{}
+	release chan struct{}
+}
+
+func (s *chainsServer) GetChains(c *gin.Context) {
+	s.lists.Add(1)
+	s.Server.GetChains(c)
+}
+
+func (s *chainsServer) GetChain(c *gin.Context, chain api.Chain) {
+	s.gets.Add(1)
+	if s.held != nil {
+		s.held <- struct{}{}
+		<-s.release
+	}
+	s.Server.GetChain(c, chain)
+}
+
+func setupChainCache(t *testing.T, server *chainsServer) *api.ChainCache {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, server)
+
+	httpServer := httptest.NewServer(router)
+	t.Cleanup(httpServer.Close)
+
+	return api.NewChainCache(funcs.Must(api.NewClientWithResponses(httpServer.URL)), time.Hour)
+}
+
+func TestChainCache(t *testing.T) {
+	server := &chainsServer{Server: memserver.New(memserver.WithChainInfo(solanaInfo, ethereumInfo))}
+	cache := setupChainCache(t, server)
+
+	chains, err := cache.Chains(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []api.ChainInfo{ethereumInfo, solanaInfo}, chains)
+
+	chain, err := cache.Chain(context.Background(), "solana")
+	require.NoError(t, err)
+	assert.Equal(t, solanaInfo, chain)
+	assert.EqualValues(t, 1, server.lists.Load(), "registry must be served from the cache")
+	assert.Zero(t, server.gets.Load())
+
+	suiInfo := ethereumInfo
+	suiInfo.Name, suiInfo.AddressFormat = "sui", api.AddressFormatSui
+	server.RegisterChainInfo(suiInfo)
+
+	chain, err = cache.Chain(context.Background(), "sui")
+	require.NoError(t, err)
+	assert.Equal(t, suiInfo, chain)
+	assert.EqualValues(t, 1, server.gets.Load(), "chains missing from the registry must be fetched")
+
+	_, err = cache.Chain(context.Background(), "sui")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, server.gets.Load(), "fetched chains must be cached")
+
+	_, err = cache.Chain(context.Background(), "unknown")
+	assert.ErrorIs(t, err, api.ErrChainNotFound)
+	_, err = cache.Chain(context.Background(), "unknown")
+	assert.ErrorIs(t, err, api.ErrChainNotFound)
+	assert.EqualValues(t, 2, server.gets.Load(), "unknown chains must be cached until the next refresh")
+
+	cache.Purge()
+	chains, err = cache.Chains(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []api.ChainInfo{ethereumInfo, solanaInfo, suiInfo}, chains)
+	assert.EqualValues(t, 2, server.lists.Load())
+
+	_, err = cache.Chain(context.Background(), "unknown")
+	assert.ErrorIs(t, err, api.ErrChainNotFound)
+	assert.EqualValues(t, 3, server.gets.Load(), "refresh must forget unknown chains")
+}
+
+func TestChainCache_Concurrent(t *testing.T) {
+	server := &chainsServer{
+		Server:  memserver.New(memserver.WithChainInfo(ethereumInfo)),
+		held:    make(chan struct{}, 5),
+		release: make(chan struct{}),
+	}
+	cache := setupChainCache(t, server)
+	funcs.Must(cache.Chains(context.Background()))
+
+	server.RegisterChainInfo(solanaInfo)
+
+	var wg sync.WaitGroup
+	results := make([]api.ChainInfo, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = funcs.Must(cache.Chain(context.Background(), "solana"))
+		}()
+	}
+
+	<-server.held
+
+	// cached chains must be served while a chain is fetched
+	chain, err := cache.Chain(context.Background(), "ethereum")
+	require.NoError(t, err)
+	assert.Equal(t, ethereumInfo, chain)
+
+	// let the other lookups join the pending request
+	time.Sleep(20 * time.Millisecond)
+	close(server.release)
+	wg.Wait()
+
+	for _, result := range results {
+		assert.Equal(t, solanaInfo, result)
+	}
+	assert.EqualValues(t, 1, server.gets.Load(), "concurrent lookups must share the request")
+	assert.EqualValues(t, 1, server.lists.Load())
+}
+
+func TestChainCache_CallerCancelled(t *testing.T) {
+	server := &chainsServer{
+		Server:  memserver.New(memserver.WithChainInfo(ethereumInfo)),
+		held:    make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	cache := setupChainCache(t, server)
+	funcs.Must(cache.Chains(context.Background()))
+
+	server.RegisterChainInfo(solanaInfo)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan error, 1)
+	go func() {
+		_, err := cache.Chain(ctx, "solana")
+		cancelled <- err
+	}()
+
+	<-server.held
+
+	waited := make(chan api.ChainInfo, 1)
+	go func() {
+		waited <- funcs.Must(cache.Chain(context.Background(), "solana"))
+	}()
+
+	// let the second lookup join the pending request before the first caller gives up
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-cancelled, context.Canceled, "the cancelled caller must stop waiting right away")
+
+	close(server.release)
+	assert.Equal(t, solanaInfo, <-waited, "the other caller must not fail with the cancellation of the first")
+	assert.EqualValues(t, 1, server.gets.Load())
+}
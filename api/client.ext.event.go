@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrEventNotFound is an error when the event or its chain doesn't exist, e.g. because it was never accepted
+var ErrEventNotFound = errors.New("event not found")
+
+// GetEventStatus retrieves a published event with its ingestion status and the message it's linked to.
+// A 404 response is returned as ErrEventNotFound.
+func GetEventStatus(ctx context.Context, client ClientWithResponsesInterface, chain Chain, eventID EventID) (GetEventResult, error) {
+	res, err := client.GetEventWithResponse(ctx, chain, eventID)
+	if err != nil {
+		return GetEventResult{}, fmt.Errorf("failed to get event %s of chain %s: %w", eventID, chain, err)
+	}
+
+	switch res.StatusCode() {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return GetEventResult{}, fmt.Errorf("%w: %s", ErrEventNotFound, errorResponseMessage(res.JSON404, res.Body))
+	default:
+		return GetEventResult{}, fmt.Errorf("failed to get event %s of chain %s: unexpected status %s: %s", eventID, chain, res.Status(),
+			errorResponseMessage(res.JSON500, res.Body))
+	}
+
+	if res.JSON200 == nil {
+		return GetEventResult{}, fmt.Errorf("failed to get event %s of chain %s: empty response", eventID, chain)
+	}
+
+	return *res.JSON200, nil
+}
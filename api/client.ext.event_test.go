@@ -0,0 +1,58 @@
+package api_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/memserver"
+)
+
+func TestGetEventStatus(t *testing.T) {
+	server := memserver.New(memserver.WithChains("ethereum"))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, server)
+
+	httpServer := httptest.NewServer(router)
+	t.Cleanup(httpServer.Close)
+
+	client := funcs.Must(api.NewClientWithResponses(httpServer.URL))
+
+	event := apitest.GasCreditEvent().Build()
+	eventID := funcs.Must(event.GetEventID())
+	credit := funcs.Must(event.AsGasCreditEvent())
+
+	res, err := client.PublishEventsWithResponse(context.Background(), "ethereum", api.PublishEventsRequest{Events: []api.Event{event}})
+	require.NoError(t, err)
+	require.NotNil(t, res.JSON200)
+
+	result, err := api.GetEventStatus(context.Background(), client, "ethereum", eventID)
+	require.NoError(t, err)
+	assert.Equal(t, api.EventIngestionStatusIndexed, result.Status)
+	assert.Nil(t, result.Error)
+	require.NotNil(t, result.CrossChainID)
+	assert.Equal(t, api.CrossChainID{SourceChain: "ethereum", MessageID: credit.MessageID}, *result.CrossChainID)
+
+	require.NoError(t, server.SetEventStatus("ethereum", eventID, api.EventIngestionStatusFailed, "message not found"))
+
+	result, err = api.GetEventStatus(context.Background(), client, "ethereum", eventID)
+	require.NoError(t, err)
+	assert.Equal(t, api.EventIngestionStatusFailed, result.Status)
+	require.NotNil(t, result.Error)
+	assert.Equal(t, "message not found", *result.Error)
+
+	_, err = api.GetEventStatus(context.Background(), client, "ethereum", "unknown")
+	assert.ErrorIs(t, err, api.ErrEventNotFound)
+
+	_, err = api.GetEventStatus(context.Background(), client, "solana", eventID)
+	assert.ErrorIs(t, err, api.ErrEventNotFound)
+}
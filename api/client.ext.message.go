@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrMessageNotFound is an error when neither events nor tasks refer to the message
+var ErrMessageNotFound = errors.New("message not found")
+
+// GetMessageStatus retrieves the stage, tasks and costs of the message with the given ID.
+// A 404 response is returned as ErrMessageNotFound.
+func GetMessageStatus(ctx context.Context, client ClientWithResponsesInterface, id CrossChainID) (GetMessageStatusResult, error) {
+	res, err := client.GetMessageStatusWithResponse(ctx, id.SourceChain, id.MessageID)
+	if err != nil {
+		return GetMessageStatusResult{}, fmt.Errorf("failed to get status of message %s from %s: %w", id.MessageID, id.SourceChain, err)
+	}
+
+	switch res.StatusCode() {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return GetMessageStatusResult{}, fmt.Errorf("%w: %s", ErrMessageNotFound, errorResponseMessage(res.JSON404, res.Body))
+	default:
+		return GetMessageStatusResult{}, fmt.Errorf("failed to get status of message %s from %s: unexpected status %s: %s", id.MessageID, id.SourceChain,
+			res.Status(), errorResponseMessage(res.JSON500, res.Body))
+	}
+
+	if res.JSON200 == nil {
+		return GetMessageStatusResult{}, fmt.Errorf("failed to get status of message %s from %s: empty response", id.MessageID, id.SourceChain)
+	}
+
+	return *res.JSON200, nil
+}
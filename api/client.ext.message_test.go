@@ -0,0 +1,116 @@
+package api_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/memserver"
+)
+
+func TestGetMessageStatus(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	server := memserver.New(memserver.WithChains("ethereum", "solana"), memserver.WithClock(func() time.Time { return now }))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, server)
+
+	httpServer := httptest.NewServer(router)
+	t.Cleanup(httpServer.Close)
+
+	client := funcs.Must(api.NewClientWithResponses(httpServer.URL))
+	publish := func(chain string, events ...api.Event) {
+		t.Helper()
+
+		res, err := client.PublishEventsWithResponse(context.Background(), chain, api.PublishEventsRequest{Events: events})
+		require.NoError(t, err)
+		require.NotNil(t, res.JSON200)
+	}
+
+	credit := apitest.GasCreditEvent().Value()
+	id := api.CrossChainID{SourceChain: "ethereum", MessageID: credit.MessageID}
+
+	_, err := api.GetMessageStatus(context.Background(), client, id)
+	assert.ErrorIs(t, err, api.ErrMessageNotFound)
+
+	publish("ethereum", apitest.GasCreditEvent().With(func(e *api.GasCreditEvent) { *e = credit }).Build())
+
+	status, err := api.GetMessageStatus(context.Background(), client, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.MessageStageCalled, status.Stage, "gas credits only refer to messages that were sent")
+	assert.Nil(t, status.CalledAt)
+	assert.Empty(t, status.Costs)
+	assert.Equal(t, now, status.UpdatedAt)
+
+	proof := apitest.ConstructProofTask().
+		With(func(task *api.ConstructProofTask) {
+			task.Message.SourceChain = id.SourceChain
+			task.Message.MessageID = id.MessageID
+		}).
+		WithItem(func(item *api.TaskItem) {
+			item.Chain = "solana"
+			item.Timestamp = now.Add(time.Minute)
+		}).
+		Build()
+	execute := apitest.ExecuteTask().
+		With(func(task *api.ExecuteTask) {
+			task.Message.SourceChain = id.SourceChain
+			task.Message.MessageID = id.MessageID
+		}).
+		WithItem(func(item *api.TaskItem) {
+			item.Chain = "solana"
+			item.Timestamp = now.Add(2 * time.Minute)
+		}).
+		Build()
+	require.NoError(t, server.EnqueueTask(execute))
+	require.NoError(t, server.EnqueueTask(proof))
+
+	status, err = api.GetMessageStatus(context.Background(), client, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.MessageStageVerified, status.Stage)
+	assert.Equal(t, []api.TaskItemID{proof.ID, execute.ID}, status.TaskIDs, "tasks must be ordered by creation")
+	require.NotNil(t, status.VerifiedAt)
+	assert.Equal(t, proof.Timestamp, *status.VerifiedAt)
+	assert.Equal(t, execute.Timestamp, status.UpdatedAt)
+
+	now = now.Add(time.Hour)
+	fees := api.Fees{{ID: "fee-1", Token: api.UnsignedToken{Amount: "10"}}, {ID: "fee-2", Token: api.UnsignedToken{Amount: "20"}}}
+	failed := apitest.CannotExecuteTaskEvent().With(func(e *api.CannotExecuteTaskEvent) {
+		e.TaskItemID = execute.ID
+		e.Cost = &api.Cost{}
+		require.NoError(t, e.Cost.FromFees(fees))
+	})
+	publish("solana",
+		failed.Build(),
+		apitest.CannotExecuteMessageEvent().With(func(e *api.CannotExecuteMessageEvent) { e.TaskItemID = execute.ID }).Build(),
+	)
+
+	status, err = api.GetMessageStatus(context.Background(), client, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.MessageStageFailed, status.Stage)
+	assert.Equal(t, fees, status.Costs)
+	assert.Equal(t, now, status.UpdatedAt)
+
+	executed := apitest.MessageExecutedEvent().With(func(e *api.MessageExecutedEvent) {
+		e.SourceChain = id.SourceChain
+		e.MessageID = id.MessageID
+	})
+	publish("solana", executed.Build())
+
+	status, err = api.GetMessageStatus(context.Background(), client, id)
+	require.NoError(t, err)
+	assert.Equal(t, api.MessageStageExecuted, status.Stage, "a successful execution must supersede a failed attempt")
+	require.NotNil(t, status.ExecutedAt)
+	assert.Equal(t, now, *status.ExecutedAt)
+	require.Len(t, status.Costs, 3)
+	assert.Equal(t, api.Fee{ID: executed.Value().EventID, Token: funcs.Must(executed.Value().Cost.AsUnsignedToken())}, status.Costs[2])
+}
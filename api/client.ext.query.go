@@ -0,0 +1,235 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+// ErrContractNotFound is an error when the queried contract doesn't exist. ContractNotFoundError matches it with errors.Is.
+var ErrContractNotFound = errors.New("contract not found")
+
+// ContractNotFoundError is returned by Query when the API responds with 404
+type ContractNotFoundError struct {
+	Contract WasmContractAddress
+	Message  string
+}
+
+// Error implements error
+func (e *ContractNotFoundError) Error() string {
+	return fmt.Sprintf("contract %s not found: %s", e.Contract, e.Message)
+}
+
+// Is allows matching ContractNotFoundError with ErrContractNotFound
+func (e *ContractNotFoundError) Is(target error) bool {
+	return target == ErrContractNotFound
+}
+
+// ContractQueryError is returned by Query when the API responds with a non-200 status other than 404
+type ContractQueryError struct {
+	Contract   WasmContractAddress
+	StatusCode int
+	Message    string
+}
+
+// Error implements error
+func (e *ContractQueryError) Error() string {
+	return fmt.Sprintf("query to contract %s failed with status %d: %s", e.Contract, e.StatusCode, e.Message)
+}
+
+// QueryOption configures Query
+type QueryOption func(*queryOptions)
+
+type queryOptions struct {
+	cache *QueryCache
+}
+
+// WithQueryCache makes Query serve responses from the given cache and store successful responses in it
+func WithQueryCache(cache *QueryCache) QueryOption {
+	return func(o *queryOptions) {
+		o.cache = cache
+	}
+}
+
+// Query sends request to QueryContractState of the contract and decodes the response into T.
+// The request can be a WasmRequest, a string (sent as a string body) or any value that marshals into a JSON object,
+// e.g. VotingVerifierMessagesStatus.
+// A 404 response is returned as *ContractNotFoundError, other non-200 responses as *ContractQueryError.
+func Query[T any](
+	ctx context.Context,
+	client ClientWithResponsesInterface,
+	contract WasmContractAddress,
+	request any,
+	opts ...QueryOption,
+) (T, error) {
+	var (
+		result  T
+		options queryOptions
+	)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	wasmRequest, err := toWasmRequest(request)
+	if err != nil {
+		return result, err
+	}
+
+	var cacheKey string
+	if options.cache != nil {
+		cacheKey, err = queryCacheKey(contract, wasmRequest)
+		if err != nil {
+			return result, err
+		}
+
+		if body, ok := options.cache.get(cacheKey); ok {
+			return decodeQueryResponse[T](contract, body)
+		}
+	}
+
+	response, err := client.QueryContractStateWithResponse(ctx, contract, wasmRequest)
+	if err != nil {
+		return result, fmt.Errorf("failed to query contract %s: %w", contract, err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return result, &ContractNotFoundError{
+			Contract: contract,
+			Message:  errorResponseMessage(response.JSON404, response.Body),
+		}
+	default:
+		return result, &ContractQueryError{
+			Contract:   contract,
+			StatusCode: response.StatusCode(),
+			Message:    errorResponseMessage(funcs.FirstNonNil(response.JSON400, response.JSON500), response.Body),
+		}
+	}
+
+	result, err = decodeQueryResponse[T](contract, response.Body)
+	if err != nil {
+		return result, err
+	}
+
+	if options.cache != nil {
+		options.cache.set(cacheKey, response.Body)
+	}
+
+	return result, nil
+}
+
+// QueryCache is a short-lived cache of contract query responses keyed by contract address and request hash.
+// It's safe for concurrent use and can be shared between Query calls with different response types.
+type QueryCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]queryCacheEntry
+}
+
+type queryCacheEntry struct {
+	body      []byte
+	expiresAt time.Time
+}
+
+// NewQueryCache creates a QueryCache keeping responses for the given duration
+func NewQueryCache(ttl time.Duration) *QueryCache {
+	return &QueryCache{
+		ttl:     ttl,
+		entries: make(map[string]queryCacheEntry),
+	}
+}
+
+// Purge removes all cached responses
+func (c *QueryCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+}
+
+func (c *QueryCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return entry.body, true
+}
+
+func (c *QueryCache) set(key string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = queryCacheEntry{
+		body:      body,
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+func queryCacheKey(contract WasmContractAddress, request WasmRequest) (string, error) {
+	body, err := request.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(body)
+	return contract + "/" + hex.EncodeToString(hash[:]), nil
+}
+
+func toWasmRequest(request any) (WasmRequest, error) {
+	switch r := request.(type) {
+	case WasmRequest:
+		return r, nil
+	case *WasmRequest:
+		if r == nil {
+			return WasmRequest{}, errors.New("wasm request cannot be nil")
+		}
+		return *r, nil
+	case string:
+		return WasmRequestFromString(r)
+	default:
+		return WasmRequestFromObject(r)
+	}
+}
+
+func decodeQueryResponse[T any](contract WasmContractAddress, body []byte) (T, error) {
+	var result T
+	if err := json.Unmarshal(body, &result); err != nil {
+		return result, fmt.Errorf("failed to decode response of contract %s: %w", contract, err)
+	}
+
+	return result, nil
+}
+
+func errorResponseMessage(response *ErrorResponse, body []byte) string {
+	if response != nil {
+		return response.Error
+	}
+
+	return string(body)
+}
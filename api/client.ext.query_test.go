@@ -0,0 +1,136 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+const (
+	knownContract   = "axelar16mek8sdcsq78jltfue35zhm5ds0cxpl0dfnrel8kck3jwtecdtnqcejdav"
+	unknownContract = "axelar1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq"
+)
+
+type verifierSetResponse struct {
+	ID          string              `json:"id"`
+	VerifierSet api.WasmVerifierSet `json:"verifier_set"`
+}
+
+func newQueryTestClient(t *testing.T) (*api.ClientWithResponses, *atomic.Int32) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/contracts/" + knownContract + "/queries":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id": "set-1", "verifier_set": {"signers": {}, "threshold": "2", "created_at": 10}}`))
+		case "/contracts/" + unknownContract + "/queries":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": "no such contract"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error": "boom"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return funcs.Must(api.NewClientWithResponses(server.URL)), &calls
+}
+
+func TestQuery(t *testing.T) {
+	request := map[string]any{"current_verifier_set": map[string]any{}}
+
+	t.Run("should decode response into type", func(t *testing.T) {
+		client, _ := newQueryTestClient(t)
+
+		result, err := api.Query[verifierSetResponse](context.Background(), client, knownContract, request)
+		require.NoError(t, err)
+		assert.Equal(t, "set-1", result.ID)
+		assert.Equal(t, "2", result.VerifierSet.Threshold)
+	})
+
+	t.Run("should return typed error when contract isn't found", func(t *testing.T) {
+		client, _ := newQueryTestClient(t)
+
+		_, err := api.Query[verifierSetResponse](context.Background(), client, unknownContract, request)
+		assert.ErrorIs(t, err, api.ErrContractNotFound)
+
+		var notFound *api.ContractNotFoundError
+		require.ErrorAs(t, err, &notFound)
+		assert.Equal(t, "no such contract", notFound.Message)
+	})
+
+	t.Run("should return query error on other statuses", func(t *testing.T) {
+		client, _ := newQueryTestClient(t)
+
+		_, err := api.Query[verifierSetResponse](context.Background(), client, "axelar1other", request)
+
+		var queryErr *api.ContractQueryError
+		require.ErrorAs(t, err, &queryErr)
+		assert.Equal(t, http.StatusInternalServerError, queryErr.StatusCode)
+		assert.Equal(t, "boom", queryErr.Message)
+	})
+
+	t.Run("should reject requests that aren't objects or strings", func(t *testing.T) {
+		client, calls := newQueryTestClient(t)
+
+		_, err := api.Query[verifierSetResponse](context.Background(), client, knownContract, []int{1})
+		assert.Error(t, err)
+		assert.Zero(t, calls.Load())
+	})
+
+	t.Run("should reject nil requests", func(t *testing.T) {
+		client, calls := newQueryTestClient(t)
+
+		_, err := api.Query[verifierSetResponse](context.Background(), client, knownContract, (*api.WasmRequest)(nil))
+		assert.Error(t, err)
+		assert.Zero(t, calls.Load())
+	})
+
+	t.Run("should serve repeated queries from cache", func(t *testing.T) {
+		client, calls := newQueryTestClient(t)
+		cache := api.NewQueryCache(time.Minute)
+
+		for range 3 {
+			result, err := api.Query[verifierSetResponse](context.Background(), client, knownContract, request, api.WithQueryCache(cache))
+			require.NoError(t, err)
+			assert.Equal(t, "set-1", result.ID)
+		}
+		assert.Equal(t, int32(1), calls.Load())
+
+		_, err := api.Query[map[string]any](context.Background(), client, knownContract, "other", api.WithQueryCache(cache))
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+
+		cache.Purge()
+		_, err = api.Query[verifierSetResponse](context.Background(), client, knownContract, request, api.WithQueryCache(cache))
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("should not serve expired responses", func(t *testing.T) {
+		client, calls := newQueryTestClient(t)
+		cache := api.NewQueryCache(time.Millisecond)
+
+		_, err := api.Query[verifierSetResponse](context.Background(), client, knownContract, request, api.WithQueryCache(cache))
+		require.NoError(t, err)
+
+		time.Sleep(5 * time.Millisecond)
+
+		_, err = api.Query[verifierSetResponse](context.Background(), client, knownContract, request, api.WithQueryCache(cache))
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
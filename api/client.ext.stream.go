@@ -0,0 +1,244 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskStreamEvent is the SSE event type of tasks sent by StreamTasks
+const TaskStreamEvent = "task"
+
+const (
+	defaultReconnectDelay    = time.Second
+	defaultMaxReconnectDelay = 30 * time.Second
+)
+
+// ErrStreamTasks is an error when the task stream is refused, e.g. because the chain doesn't exist. ConsumeTasks doesn't retry such errors.
+var ErrStreamTasks = errors.New("failed to stream tasks")
+
+// StreamOption configures ConsumeTasks
+type StreamOption func(*streamOptions)
+
+type streamOptions struct {
+	after             *uuid.UUID
+	reconnectDelay    time.Duration
+	maxReconnectDelay time.Duration
+}
+
+// WithStreamAfter starts the stream after the given task, e.g. the last task handled before a restart
+func WithStreamAfter(taskItemID uuid.UUID) StreamOption {
+	return func(o *streamOptions) {
+		o.after = &taskItemID
+	}
+}
+
+// WithReconnectDelay sets the delay before the first attempt to reconnect a lost stream. The delay doubles with every failed attempt,
+// up to the delay set by WithMaxReconnectDelay, and is reset once the stream is connected again. Defaults to 1s.
+func WithReconnectDelay(delay time.Duration) StreamOption {
+	return func(o *streamOptions) {
+		o.reconnectDelay = delay
+	}
+}
+
+// WithMaxReconnectDelay sets the maximum delay between attempts to reconnect a lost stream. Defaults to 30s.
+func WithMaxReconnectDelay(delay time.Duration) StreamOption {
+	return func(o *streamOptions) {
+		o.maxReconnectDelay = delay
+	}
+}
+
+// ConsumeTasks streams tasks of the chain with StreamTasks and hands them to handle in order,
+// until ctx is done or handle returns an error. Lost connections, 429 and server errors are retried with jittered exponential backoff,
+// waiting at least as long as a Retry-After header asks for. The stream reconnects with the ID of the last handled task
+// as Last-Event-ID, so that no task is skipped.
+// Refused streams, e.g. of unknown chains, are returned as ErrStreamTasks.
+func ConsumeTasks(
+	ctx context.Context,
+	client ClientInterface,
+	chain Chain,
+	handle func(ctx context.Context, task TaskItem) error,
+	opts ...StreamOption,
+) error {
+	options := streamOptions{reconnectDelay: defaultReconnectDelay, maxReconnectDelay: defaultMaxReconnectDelay}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	params := &StreamTasksParams{After: options.after}
+	delay := options.reconnectDelay
+	for {
+		err := consumeStream(ctx, client, chain, params, handle)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var retriable *retriableStreamError
+		if !errors.As(err, &retriable) {
+			return err
+		}
+
+		if retriable.connected {
+			delay = options.reconnectDelay
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(max(jitter(delay), retriable.retryAfter)):
+		}
+
+		delay = min(delay*2, options.maxReconnectDelay)
+	}
+}
+
+// jitter spreads the delay over [delay/2, delay], so that clients dropped at once don't reconnect at once
+func jitter(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		return 0
+	}
+
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// parseRetryAfter returns the delay of a Retry-After header in seconds or as an HTTP date, or 0 if there's none
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+
+	return 0
+}
+
+// retriableStreamError is an error after which the stream is reconnected
+type retriableStreamError struct {
+	err error
+	// connected is set if the stream was established before it was lost
+	connected bool
+	// retryAfter is the delay the server asked for
+	retryAfter time.Duration
+}
+
+func (e *retriableStreamError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retriableStreamError) Unwrap() error {
+	return e.err
+}
+
+// consumeStream consumes a single connection. It advances params.LastEventID with every handled task.
+func consumeStream(
+	ctx context.Context,
+	client ClientInterface,
+	chain Chain,
+	params *StreamTasksParams,
+	handle func(ctx context.Context, task TaskItem) error,
+) error {
+	res, err := client.StreamTasks(ctx, chain, params)
+	if err != nil {
+		return &retriableStreamError{err: err}
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch {
+	case res.StatusCode == http.StatusOK:
+	case res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError:
+		return &retriableStreamError{
+			err:        fmt.Errorf("unexpected status %s", res.Status),
+			retryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+		}
+	default:
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("%w: unexpected status %s: %s", ErrStreamTasks, res.Status, body)
+	}
+
+	reader := bufio.NewReader(res.Body)
+	for {
+		event, err := readSSEEvent(reader)
+		if err != nil {
+			return &retriableStreamError{err: err, connected: true}
+		}
+
+		if event.event != TaskStreamEvent {
+			continue
+		}
+
+		var task TaskItem
+		if err := json.Unmarshal([]byte(event.data), &task); err != nil {
+			return fmt.Errorf("failed to decode task %s: %w", event.id, err)
+		}
+
+		if err := handle(ctx, task); err != nil {
+			return err
+		}
+
+		params.LastEventID = &task.ID
+	}
+}
+
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+// readSSEEvent reads the next event of the stream. Comments, e.g. heartbeats, and fields other than id, event and data are skipped.
+func readSSEEvent(r *bufio.Reader) (sseEvent, error) {
+	var (
+		event sseEvent
+		data  []string
+	)
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return sseEvent{}, err
+		}
+
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+		if line == "" {
+			if len(data) == 0 {
+				// an event without data isn't dispatched
+				event = sseEvent{}
+				continue
+			}
+
+			event.data = strings.Join(data, "\n")
+			if event.event == "" {
+				event.event = "message"
+			}
+			return event, nil
+		}
+
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+
+		switch field {
+		case "id":
+			event.id = value
+		case "event":
+			event.event = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+}
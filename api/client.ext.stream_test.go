@@ -0,0 +1,177 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+var errDone = errors.New("done")
+
+func TestConsumeTasks(t *testing.T) {
+	tasks := []api.TaskItem{apitest.ExecuteTask().Build(), apitest.RefundTask().Build(), apitest.VerifyTask().Build()}
+
+	var (
+		mu      sync.Mutex
+		cursors []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		cursors = append(cursors, r.URL.Query().Get("after")+"|"+r.Header.Get("Last-Event-ID"))
+		connection := len(cursors)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		switch connection {
+		case 1:
+			// the first connection drops after the first task
+			_, _ = fmt.Fprintf(w, ": heartbeat\n\nevent: task\nid: %s\ndata: %s\n\n", tasks[0].ID, funcs.Must(json.Marshal(tasks[0])))
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			for _, task := range tasks[1:] {
+				_, _ = fmt.Fprintf(w, "event: ping\ndata: {}\n\nevent: task\nid: %s\ndata: %s\r\n\r\n", task.ID, funcs.Must(json.Marshal(task)))
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	start := uuid.New()
+	var received []uuid.UUID
+	err := api.ConsumeTasks(context.Background(), funcs.Must(api.NewClient(server.URL)), "ethereum",
+		func(_ context.Context, task api.TaskItem) error {
+			received = append(received, task.ID)
+			if len(received) == len(tasks) {
+				return errDone
+			}
+			return nil
+		},
+		api.WithStreamAfter(start),
+		api.WithReconnectDelay(time.Millisecond),
+	)
+
+	assert.ErrorIs(t, err, errDone)
+	assert.Equal(t, []uuid.UUID{tasks[0].ID, tasks[1].ID, tasks[2].ID}, received)
+	assert.Equal(t, []string{
+		start.String() + "|",
+		start.String() + "|" + tasks[0].ID.String(),
+		start.String() + "|" + tasks[0].ID.String(),
+	}, cursors, "reconnects must resume after the last received task")
+}
+
+func TestConsumeTasks_Refused(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"chain not found"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	err := api.ConsumeTasks(context.Background(), funcs.Must(api.NewClient(server.URL)), "unknown",
+		func(context.Context, api.TaskItem) error { return nil })
+	assert.ErrorIs(t, err, api.ErrStreamTasks)
+	assert.ErrorContains(t, err, "chain not found")
+}
+
+func TestConsumeTasks_ContextDone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := api.ConsumeTasks(ctx, funcs.Must(api.NewClient(server.URL)), "ethereum",
+		func(context.Context, api.TaskItem) error { return nil }, api.WithReconnectDelay(time.Millisecond))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestConsumeTasks_Backoff(t *testing.T) {
+	task := apitest.ExecuteTask().Build()
+
+	var (
+		mu          sync.Mutex
+		connections []time.Time
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		connections = append(connections, time.Now())
+		connection := len(connections)
+		mu.Unlock()
+
+		switch connection {
+		case 1, 2, 3, 5:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			// the fourth connection drops without a task, the sixth delivers it
+			w.Header().Set("Content-Type", "text/event-stream")
+			if connection == 6 {
+				_, _ = fmt.Fprintf(w, "event: task\nid: %s\ndata: %s\n\n", task.ID, funcs.Must(json.Marshal(task)))
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	delay := 50 * time.Millisecond
+	err := api.ConsumeTasks(context.Background(), funcs.Must(api.NewClient(server.URL)), "ethereum",
+		func(context.Context, api.TaskItem) error { return errDone },
+		api.WithReconnectDelay(delay),
+		api.WithMaxReconnectDelay(time.Second),
+	)
+	require.ErrorIs(t, err, errDone)
+	require.Len(t, connections, 6)
+
+	gap := func(i int) time.Duration { return connections[i].Sub(connections[i-1]) }
+	assert.GreaterOrEqual(t, gap(1), delay/2)
+	assert.GreaterOrEqual(t, gap(2), delay)
+	assert.GreaterOrEqual(t, gap(3), 2*delay)
+	assert.Less(t, gap(4), 4*delay, "the delay must reset once the stream is connected")
+	assert.GreaterOrEqual(t, gap(5), delay, "the delay must double again after the reset")
+}
+
+func TestConsumeTasks_RetryAfter(t *testing.T) {
+	task := apitest.ExecuteTask().Build()
+
+	var (
+		mu          sync.Mutex
+		connections []time.Time
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		connections = append(connections, time.Now())
+		connection := len(connections)
+		mu.Unlock()
+
+		if connection == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprintf(w, "event: task\nid: %s\ndata: %s\n\n", task.ID, funcs.Must(json.Marshal(task)))
+	}))
+	t.Cleanup(server.Close)
+
+	err := api.ConsumeTasks(context.Background(), funcs.Must(api.NewClient(server.URL)), "ethereum",
+		func(context.Context, api.TaskItem) error { return errDone },
+		api.WithReconnectDelay(time.Millisecond),
+	)
+	require.ErrorIs(t, err, errDone)
+	require.Len(t, connections, 2)
+	assert.GreaterOrEqual(t, connections[1].Sub(connections[0]), time.Second, "429 must be retried after Retry-After")
+}
@@ -89,17 +89,39 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetChains request
+	GetChains(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetChain request
+	GetChain(ctx context.Context, chain Chain, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PublishEventsWithBody request with any body
 	PublishEventsWithBody(ctx context.Context, chain Chain, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PublishEvents(ctx context.Context, chain Chain, body PublishEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetEvent request
+	GetEvent(ctx context.Context, chain Chain, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTasks request
 	GetTasks(ctx context.Context, chain Chain, params *GetTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// StreamTasks request
+	StreamTasks(ctx context.Context, chain Chain, params *StreamTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTask request
 	GetTask(ctx context.Context, chain Chain, taskItemID TaskItemID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AckTaskWithBody request with any body
+	AckTaskWithBody(ctx context.Context, chain Chain, taskItemID TaskItemID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AckTask(ctx context.Context, chain Chain, taskItemID TaskItemID, body AckTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BatchGetTasksWithBody request with any body
+	BatchGetTasksWithBody(ctx context.Context, chain Chain, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BatchGetTasks(ctx context.Context, chain Chain, body BatchGetTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// BroadcastMsgExecuteContractWithBody request with any body
 	BroadcastMsgExecuteContractWithBody(ctx context.Context, wasmContractAddress WasmContractAddress, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -116,6 +138,9 @@ type ClientInterface interface {
 	// HealthCheck request
 	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetMessageStatus request
+	GetMessageStatus(ctx context.Context, sourceChain SourceChain, messageID MessageID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// StorePayloadWithBody request with any body
 	StorePayloadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -123,6 +148,30 @@ type ClientInterface interface {
 	GetPayload(ctx context.Context, hash Keccak256Hash, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) GetChains(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetChainsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetChain(ctx context.Context, chain Chain, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetChainRequest(c.Server, chain)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PublishEventsWithBody(ctx context.Context, chain Chain, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPublishEventsRequestWithBody(c.Server, chain, contentType, body)
 	if err != nil {
@@ -147,6 +196,18 @@ func (c *Client) PublishEvents(ctx context.Context, chain Chain, body PublishEve
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetEvent(ctx context.Context, chain Chain, eventID EventID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventRequest(c.Server, chain, eventID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetTasks(ctx context.Context, chain Chain, params *GetTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetTasksRequest(c.Server, chain, params)
 	if err != nil {
@@ -159,8 +220,8 @@ func (c *Client) GetTasks(ctx context.Context, chain Chain, params *GetTasksPara
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTask(ctx context.Context, chain Chain, taskItemID TaskItemID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTaskRequest(c.Server, chain, taskItemID)
+func (c *Client) StreamTasks(ctx context.Context, chain Chain, params *StreamTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamTasksRequest(c.Server, chain, params)
 	if err != nil {
 		return nil, err
 	}
@@ -171,8 +232,8 @@ func (c *Client) GetTask(ctx context.Context, chain Chain, taskItemID TaskItemID
 	return c.Client.Do(req)
 }
 
-func (c *Client) BroadcastMsgExecuteContractWithBody(ctx context.Context, wasmContractAddress WasmContractAddress, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewBroadcastMsgExecuteContractRequestWithBody(c.Server, wasmContractAddress, contentType, body)
+func (c *Client) GetTask(ctx context.Context, chain Chain, taskItemID TaskItemID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTaskRequest(c.Server, chain, taskItemID)
 	if err != nil {
 		return nil, err
 	}
@@ -183,8 +244,8 @@ func (c *Client) BroadcastMsgExecuteContractWithBody(ctx context.Context, wasmCo
 	return c.Client.Do(req)
 }
 
-func (c *Client) BroadcastMsgExecuteContract(ctx context.Context, wasmContractAddress WasmContractAddress, body BroadcastMsgExecuteContractJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewBroadcastMsgExecuteContractRequest(c.Server, wasmContractAddress, body)
+func (c *Client) AckTaskWithBody(ctx context.Context, chain Chain, taskItemID TaskItemID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAckTaskRequestWithBody(c.Server, chain, taskItemID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -195,8 +256,8 @@ func (c *Client) BroadcastMsgExecuteContract(ctx context.Context, wasmContractAd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMsgExecuteContractBroadcastStatus(ctx context.Context, wasmContractAddress WasmContractAddress, broadcastID BroadcastID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMsgExecuteContractBroadcastStatusRequest(c.Server, wasmContractAddress, broadcastID)
+func (c *Client) AckTask(ctx context.Context, chain Chain, taskItemID TaskItemID, body AckTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAckTaskRequest(c.Server, chain, taskItemID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -207,8 +268,8 @@ func (c *Client) GetMsgExecuteContractBroadcastStatus(ctx context.Context, wasmC
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryContractStateWithBody(ctx context.Context, wasmContractAddress WasmContractAddress, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryContractStateRequestWithBody(c.Server, wasmContractAddress, contentType, body)
+func (c *Client) BatchGetTasksWithBody(ctx context.Context, chain Chain, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchGetTasksRequestWithBody(c.Server, chain, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -219,8 +280,8 @@ func (c *Client) QueryContractStateWithBody(ctx context.Context, wasmContractAdd
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryContractState(ctx context.Context, wasmContractAddress WasmContractAddress, body QueryContractStateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryContractStateRequest(c.Server, wasmContractAddress, body)
+func (c *Client) BatchGetTasks(ctx context.Context, chain Chain, body BatchGetTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchGetTasksRequest(c.Server, chain, body)
 	if err != nil {
 		return nil, err
 	}
@@ -231,8 +292,8 @@ func (c *Client) QueryContractState(ctx context.Context, wasmContractAddress Was
 	return c.Client.Do(req)
 }
 
-func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthCheckRequest(c.Server)
+func (c *Client) BroadcastMsgExecuteContractWithBody(ctx context.Context, wasmContractAddress WasmContractAddress, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBroadcastMsgExecuteContractRequestWithBody(c.Server, wasmContractAddress, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -243,8 +304,8 @@ func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) StorePayloadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStorePayloadRequestWithBody(c.Server, contentType, body)
+func (c *Client) BroadcastMsgExecuteContract(ctx context.Context, wasmContractAddress WasmContractAddress, body BroadcastMsgExecuteContractJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBroadcastMsgExecuteContractRequest(c.Server, wasmContractAddress, body)
 	if err != nil {
 		return nil, err
 	}
@@ -255,8 +316,8 @@ func (c *Client) StorePayloadWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPayload(ctx context.Context, hash Keccak256Hash, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPayloadRequest(c.Server, hash)
+func (c *Client) GetMsgExecuteContractBroadcastStatus(ctx context.Context, wasmContractAddress WasmContractAddress, broadcastID BroadcastID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMsgExecuteContractBroadcastStatusRequest(c.Server, wasmContractAddress, broadcastID)
 	if err != nil {
 		return nil, err
 	}
@@ -267,70 +328,88 @@ func (c *Client) GetPayload(ctx context.Context, hash Keccak256Hash, reqEditors
 	return c.Client.Do(req)
 }
 
-// NewPublishEventsRequest calls the generic PublishEvents builder with application/json body
-func NewPublishEventsRequest(server string, chain Chain, body PublishEventsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) QueryContractStateWithBody(ctx context.Context, wasmContractAddress WasmContractAddress, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryContractStateRequestWithBody(c.Server, wasmContractAddress, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPublishEventsRequestWithBody(server, chain, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPublishEventsRequestWithBody generates requests for PublishEvents with any type of body
-func NewPublishEventsRequestWithBody(server string, chain Chain, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "chain", runtime.ParamLocationPath, chain)
+func (c *Client) QueryContractState(ctx context.Context, wasmContractAddress WasmContractAddress, body QueryContractStateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryContractStateRequest(c.Server, wasmContractAddress, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/chains/%s/events", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetMessageStatus(ctx context.Context, sourceChain SourceChain, messageID MessageID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMessageStatusRequest(c.Server, sourceChain, messageID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) StorePayloadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStorePayloadRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetTasksRequest generates requests for GetTasks
-func NewGetTasksRequest(server string, chain Chain, params *GetTasksParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "chain", runtime.ParamLocationPath, chain)
+func (c *Client) GetPayload(ctx context.Context, hash Keccak256Hash, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPayloadRequest(c.Server, hash)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetChainsRequest generates requests for GetChains
+func NewGetChainsRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/chains/%s/tasks", pathParam0)
+	operationPath := fmt.Sprintf("/chains")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -340,44 +419,6 @@ func NewGetTasksRequest(server string, chain Chain, params *GetTasksParams) (*ht
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.After != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -386,8 +427,8 @@ func NewGetTasksRequest(server string, chain Chain, params *GetTasksParams) (*ht
 	return req, nil
 }
 
-// NewGetTaskRequest generates requests for GetTask
-func NewGetTaskRequest(server string, chain Chain, taskItemID TaskItemID) (*http.Request, error) {
+// NewGetChainRequest generates requests for GetChain
+func NewGetChainRequest(server string, chain Chain) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -397,19 +438,12 @@ func NewGetTaskRequest(server string, chain Chain, taskItemID TaskItemID) (*http
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "taskItemID", runtime.ParamLocationPath, taskItemID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/chains/%s/tasks/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/chains/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -427,24 +461,24 @@ func NewGetTaskRequest(server string, chain Chain, taskItemID TaskItemID) (*http
 	return req, nil
 }
 
-// NewBroadcastMsgExecuteContractRequest calls the generic BroadcastMsgExecuteContract builder with application/json body
-func NewBroadcastMsgExecuteContractRequest(server string, wasmContractAddress WasmContractAddress, body BroadcastMsgExecuteContractJSONRequestBody) (*http.Request, error) {
+// NewPublishEventsRequest calls the generic PublishEvents builder with application/json body
+func NewPublishEventsRequest(server string, chain Chain, body PublishEventsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewBroadcastMsgExecuteContractRequestWithBody(server, wasmContractAddress, "application/json", bodyReader)
+	return NewPublishEventsRequestWithBody(server, chain, "application/json", bodyReader)
 }
 
-// NewBroadcastMsgExecuteContractRequestWithBody generates requests for BroadcastMsgExecuteContract with any type of body
-func NewBroadcastMsgExecuteContractRequestWithBody(server string, wasmContractAddress WasmContractAddress, contentType string, body io.Reader) (*http.Request, error) {
+// NewPublishEventsRequestWithBody generates requests for PublishEvents with any type of body
+func NewPublishEventsRequestWithBody(server string, chain Chain, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wasmContractAddress", runtime.ParamLocationPath, wasmContractAddress)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "chain", runtime.ParamLocationPath, chain)
 	if err != nil {
 		return nil, err
 	}
@@ -454,7 +488,7 @@ func NewBroadcastMsgExecuteContractRequestWithBody(server string, wasmContractAd
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/contracts/%s/broadcasts", pathParam0)
+	operationPath := fmt.Sprintf("/chains/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -474,20 +508,20 @@ func NewBroadcastMsgExecuteContractRequestWithBody(server string, wasmContractAd
 	return req, nil
 }
 
-// NewGetMsgExecuteContractBroadcastStatusRequest generates requests for GetMsgExecuteContractBroadcastStatus
-func NewGetMsgExecuteContractBroadcastStatusRequest(server string, wasmContractAddress WasmContractAddress, broadcastID BroadcastID) (*http.Request, error) {
+// NewGetEventRequest generates requests for GetEvent
+func NewGetEventRequest(server string, chain Chain, eventID EventID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wasmContractAddress", runtime.ParamLocationPath, wasmContractAddress)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "chain", runtime.ParamLocationPath, chain)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "broadcastID", runtime.ParamLocationPath, broadcastID)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "eventID", runtime.ParamLocationPath, eventID)
 	if err != nil {
 		return nil, err
 	}
@@ -497,7 +531,7 @@ func NewGetMsgExecuteContractBroadcastStatusRequest(server string, wasmContractA
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/contracts/%s/broadcasts/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/chains/%s/events/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -515,24 +549,13 @@ func NewGetMsgExecuteContractBroadcastStatusRequest(server string, wasmContractA
 	return req, nil
 }
 
-// NewQueryContractStateRequest calls the generic QueryContractState builder with application/json body
-func NewQueryContractStateRequest(server string, wasmContractAddress WasmContractAddress, body QueryContractStateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewQueryContractStateRequestWithBody(server, wasmContractAddress, "application/json", bodyReader)
-}
-
-// NewQueryContractStateRequestWithBody generates requests for QueryContractState with any type of body
-func NewQueryContractStateRequestWithBody(server string, wasmContractAddress WasmContractAddress, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetTasksRequest generates requests for GetTasks
+func NewGetTasksRequest(server string, chain Chain, params *GetTasksParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wasmContractAddress", runtime.ParamLocationPath, wasmContractAddress)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "chain", runtime.ParamLocationPath, chain)
 	if err != nil {
 		return nil, err
 	}
@@ -542,7 +565,7 @@ func NewQueryContractStateRequestWithBody(server string, wasmContractAddress Was
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/contracts/%s/queries", pathParam0)
+	operationPath := fmt.Sprintf("/chains/%s/tasks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -552,22 +575,438 @@ func NewQueryContractStateRequestWithBody(server string, wasmContractAddress Was
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.After != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewHealthCheckRequest generates requests for HealthCheck
-func NewHealthCheckRequest(server string) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait", runtime.ParamLocationQuery, *params.Wait); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamTasksRequest generates requests for StreamTasks
+func NewStreamTasksRequest(server string, chain Chain, params *StreamTasksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "chain", runtime.ParamLocationPath, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/chains/%s/tasks/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTaskRequest generates requests for GetTask
+func NewGetTaskRequest(server string, chain Chain, taskItemID TaskItemID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "chain", runtime.ParamLocationPath, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "taskItemID", runtime.ParamLocationPath, taskItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/chains/%s/tasks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAckTaskRequest calls the generic AckTask builder with application/json body
+func NewAckTaskRequest(server string, chain Chain, taskItemID TaskItemID, body AckTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAckTaskRequestWithBody(server, chain, taskItemID, "application/json", bodyReader)
+}
+
+// NewAckTaskRequestWithBody generates requests for AckTask with any type of body
+func NewAckTaskRequestWithBody(server string, chain Chain, taskItemID TaskItemID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "chain", runtime.ParamLocationPath, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "taskItemID", runtime.ParamLocationPath, taskItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/chains/%s/tasks/%s/ack", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewBatchGetTasksRequest calls the generic BatchGetTasks builder with application/json body
+func NewBatchGetTasksRequest(server string, chain Chain, body BatchGetTasksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBatchGetTasksRequestWithBody(server, chain, "application/json", bodyReader)
+}
+
+// NewBatchGetTasksRequestWithBody generates requests for BatchGetTasks with any type of body
+func NewBatchGetTasksRequestWithBody(server string, chain Chain, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "chain", runtime.ParamLocationPath, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/chains/%s/tasks:batchGet", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewBroadcastMsgExecuteContractRequest calls the generic BroadcastMsgExecuteContract builder with application/json body
+func NewBroadcastMsgExecuteContractRequest(server string, wasmContractAddress WasmContractAddress, body BroadcastMsgExecuteContractJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBroadcastMsgExecuteContractRequestWithBody(server, wasmContractAddress, "application/json", bodyReader)
+}
+
+// NewBroadcastMsgExecuteContractRequestWithBody generates requests for BroadcastMsgExecuteContract with any type of body
+func NewBroadcastMsgExecuteContractRequestWithBody(server string, wasmContractAddress WasmContractAddress, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wasmContractAddress", runtime.ParamLocationPath, wasmContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/contracts/%s/broadcasts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMsgExecuteContractBroadcastStatusRequest generates requests for GetMsgExecuteContractBroadcastStatus
+func NewGetMsgExecuteContractBroadcastStatusRequest(server string, wasmContractAddress WasmContractAddress, broadcastID BroadcastID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wasmContractAddress", runtime.ParamLocationPath, wasmContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "broadcastID", runtime.ParamLocationPath, broadcastID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/contracts/%s/broadcasts/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewQueryContractStateRequest calls the generic QueryContractState builder with application/json body
+func NewQueryContractStateRequest(server string, wasmContractAddress WasmContractAddress, body QueryContractStateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryContractStateRequestWithBody(server, wasmContractAddress, "application/json", bodyReader)
+}
+
+// NewQueryContractStateRequestWithBody generates requests for QueryContractState with any type of body
+func NewQueryContractStateRequestWithBody(server string, wasmContractAddress WasmContractAddress, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wasmContractAddress", runtime.ParamLocationPath, wasmContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/contracts/%s/queries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
 		return nil, err
 	}
 
@@ -589,6 +1028,47 @@ func NewHealthCheckRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewGetMessageStatusRequest generates requests for GetMessageStatus
+func NewGetMessageStatusRequest(server string, sourceChain SourceChain, messageID MessageID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sourceChain", runtime.ParamLocationPath, sourceChain)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "messageID", runtime.ParamLocationPath, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/messages/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewStorePayloadRequestWithBody generates requests for StorePayload with any type of body
 func NewStorePayloadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
@@ -695,17 +1175,39 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// GetChainsWithResponse request
+	GetChainsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChainsResponse, error)
+
+	// GetChainWithResponse request
+	GetChainWithResponse(ctx context.Context, chain Chain, reqEditors ...RequestEditorFn) (*GetChainResponse, error)
+
 	// PublishEventsWithBodyWithResponse request with any body
 	PublishEventsWithBodyWithResponse(ctx context.Context, chain Chain, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PublishEventsResponse, error)
 
 	PublishEventsWithResponse(ctx context.Context, chain Chain, body PublishEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*PublishEventsResponse, error)
 
+	// GetEventWithResponse request
+	GetEventWithResponse(ctx context.Context, chain Chain, eventID EventID, reqEditors ...RequestEditorFn) (*GetEventResponse, error)
+
 	// GetTasksWithResponse request
 	GetTasksWithResponse(ctx context.Context, chain Chain, params *GetTasksParams, reqEditors ...RequestEditorFn) (*GetTasksResponse, error)
 
+	// StreamTasksWithResponse request
+	StreamTasksWithResponse(ctx context.Context, chain Chain, params *StreamTasksParams, reqEditors ...RequestEditorFn) (*StreamTasksResponse, error)
+
 	// GetTaskWithResponse request
 	GetTaskWithResponse(ctx context.Context, chain Chain, taskItemID TaskItemID, reqEditors ...RequestEditorFn) (*GetTaskResponse, error)
 
+	// AckTaskWithBodyWithResponse request with any body
+	AckTaskWithBodyWithResponse(ctx context.Context, chain Chain, taskItemID TaskItemID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AckTaskResponse, error)
+
+	AckTaskWithResponse(ctx context.Context, chain Chain, taskItemID TaskItemID, body AckTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*AckTaskResponse, error)
+
+	// BatchGetTasksWithBodyWithResponse request with any body
+	BatchGetTasksWithBodyWithResponse(ctx context.Context, chain Chain, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchGetTasksResponse, error)
+
+	BatchGetTasksWithResponse(ctx context.Context, chain Chain, body BatchGetTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchGetTasksResponse, error)
+
 	// BroadcastMsgExecuteContractWithBodyWithResponse request with any body
 	BroadcastMsgExecuteContractWithBodyWithResponse(ctx context.Context, wasmContractAddress WasmContractAddress, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BroadcastMsgExecuteContractResponse, error)
 
@@ -722,6 +1224,9 @@ type ClientWithResponsesInterface interface {
 	// HealthCheckWithResponse request
 	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
 
+	// GetMessageStatusWithResponse request
+	GetMessageStatusWithResponse(ctx context.Context, sourceChain SourceChain, messageID MessageID, reqEditors ...RequestEditorFn) (*GetMessageStatusResponse, error)
+
 	// StorePayloadWithBodyWithResponse request with any body
 	StorePayloadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StorePayloadResponse, error)
 
@@ -729,6 +1234,53 @@ type ClientWithResponsesInterface interface {
 	GetPayloadWithResponse(ctx context.Context, hash Keccak256Hash, reqEditors ...RequestEditorFn) (*GetPayloadResponse, error)
 }
 
+type GetChainsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetChainsResult
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetChainsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetChainsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetChainResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetChainResult
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetChainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetChainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type PublishEventsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -754,6 +1306,30 @@ func (r PublishEventsResponse) StatusCode() int {
 	return 0
 }
 
+type GetEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetEventResult
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetTasksResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -778,6 +1354,29 @@ func (r GetTasksResponse) StatusCode() int {
 	return 0
 }
 
+type StreamTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -802,6 +1401,56 @@ func (r GetTaskResponse) StatusCode() int {
 	return 0
 }
 
+type AckTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AckTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AckTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchGetTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchGetTasksResult
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchGetTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchGetTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type BroadcastMsgExecuteContractResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -896,6 +1545,30 @@ func (r HealthCheckResponse) StatusCode() int {
 	return 0
 }
 
+type GetMessageStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetMessageStatusResult
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMessageStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMessageStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type StorePayloadResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -943,6 +1616,24 @@ func (r GetPayloadResponse) StatusCode() int {
 	return 0
 }
 
+// GetChainsWithResponse request returning *GetChainsResponse
+func (c *ClientWithResponses) GetChainsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChainsResponse, error) {
+	rsp, err := c.GetChains(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetChainsResponse(rsp)
+}
+
+// GetChainWithResponse request returning *GetChainResponse
+func (c *ClientWithResponses) GetChainWithResponse(ctx context.Context, chain Chain, reqEditors ...RequestEditorFn) (*GetChainResponse, error) {
+	rsp, err := c.GetChain(ctx, chain, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetChainResponse(rsp)
+}
+
 // PublishEventsWithBodyWithResponse request with arbitrary body returning *PublishEventsResponse
 func (c *ClientWithResponses) PublishEventsWithBodyWithResponse(ctx context.Context, chain Chain, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PublishEventsResponse, error) {
 	rsp, err := c.PublishEventsWithBody(ctx, chain, contentType, body, reqEditors...)
@@ -960,6 +1651,15 @@ func (c *ClientWithResponses) PublishEventsWithResponse(ctx context.Context, cha
 	return ParsePublishEventsResponse(rsp)
 }
 
+// GetEventWithResponse request returning *GetEventResponse
+func (c *ClientWithResponses) GetEventWithResponse(ctx context.Context, chain Chain, eventID EventID, reqEditors ...RequestEditorFn) (*GetEventResponse, error) {
+	rsp, err := c.GetEvent(ctx, chain, eventID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventResponse(rsp)
+}
+
 // GetTasksWithResponse request returning *GetTasksResponse
 func (c *ClientWithResponses) GetTasksWithResponse(ctx context.Context, chain Chain, params *GetTasksParams, reqEditors ...RequestEditorFn) (*GetTasksResponse, error) {
 	rsp, err := c.GetTasks(ctx, chain, params, reqEditors...)
@@ -969,6 +1669,15 @@ func (c *ClientWithResponses) GetTasksWithResponse(ctx context.Context, chain Ch
 	return ParseGetTasksResponse(rsp)
 }
 
+// StreamTasksWithResponse request returning *StreamTasksResponse
+func (c *ClientWithResponses) StreamTasksWithResponse(ctx context.Context, chain Chain, params *StreamTasksParams, reqEditors ...RequestEditorFn) (*StreamTasksResponse, error) {
+	rsp, err := c.StreamTasks(ctx, chain, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamTasksResponse(rsp)
+}
+
 // GetTaskWithResponse request returning *GetTaskResponse
 func (c *ClientWithResponses) GetTaskWithResponse(ctx context.Context, chain Chain, taskItemID TaskItemID, reqEditors ...RequestEditorFn) (*GetTaskResponse, error) {
 	rsp, err := c.GetTask(ctx, chain, taskItemID, reqEditors...)
@@ -978,6 +1687,40 @@ func (c *ClientWithResponses) GetTaskWithResponse(ctx context.Context, chain Cha
 	return ParseGetTaskResponse(rsp)
 }
 
+// AckTaskWithBodyWithResponse request with arbitrary body returning *AckTaskResponse
+func (c *ClientWithResponses) AckTaskWithBodyWithResponse(ctx context.Context, chain Chain, taskItemID TaskItemID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AckTaskResponse, error) {
+	rsp, err := c.AckTaskWithBody(ctx, chain, taskItemID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAckTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) AckTaskWithResponse(ctx context.Context, chain Chain, taskItemID TaskItemID, body AckTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*AckTaskResponse, error) {
+	rsp, err := c.AckTask(ctx, chain, taskItemID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAckTaskResponse(rsp)
+}
+
+// BatchGetTasksWithBodyWithResponse request with arbitrary body returning *BatchGetTasksResponse
+func (c *ClientWithResponses) BatchGetTasksWithBodyWithResponse(ctx context.Context, chain Chain, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchGetTasksResponse, error) {
+	rsp, err := c.BatchGetTasksWithBody(ctx, chain, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchGetTasksResponse(rsp)
+}
+
+func (c *ClientWithResponses) BatchGetTasksWithResponse(ctx context.Context, chain Chain, body BatchGetTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchGetTasksResponse, error) {
+	rsp, err := c.BatchGetTasks(ctx, chain, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchGetTasksResponse(rsp)
+}
+
 // BroadcastMsgExecuteContractWithBodyWithResponse request with arbitrary body returning *BroadcastMsgExecuteContractResponse
 func (c *ClientWithResponses) BroadcastMsgExecuteContractWithBodyWithResponse(ctx context.Context, wasmContractAddress WasmContractAddress, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BroadcastMsgExecuteContractResponse, error) {
 	rsp, err := c.BroadcastMsgExecuteContractWithBody(ctx, wasmContractAddress, contentType, body, reqEditors...)
@@ -1021,13 +1764,22 @@ func (c *ClientWithResponses) QueryContractStateWithResponse(ctx context.Context
 	return ParseQueryContractStateResponse(rsp)
 }
 
-// HealthCheckWithResponse request returning *HealthCheckResponse
-func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
-	rsp, err := c.HealthCheck(ctx, reqEditors...)
+// HealthCheckWithResponse request returning *HealthCheckResponse
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResponse(rsp)
+}
+
+// GetMessageStatusWithResponse request returning *GetMessageStatusResponse
+func (c *ClientWithResponses) GetMessageStatusWithResponse(ctx context.Context, sourceChain SourceChain, messageID MessageID, reqEditors ...RequestEditorFn) (*GetMessageStatusResponse, error) {
+	rsp, err := c.GetMessageStatus(ctx, sourceChain, messageID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHealthCheckResponse(rsp)
+	return ParseGetMessageStatusResponse(rsp)
 }
 
 // StorePayloadWithBodyWithResponse request with arbitrary body returning *StorePayloadResponse
@@ -1048,6 +1800,79 @@ func (c *ClientWithResponses) GetPayloadWithResponse(ctx context.Context, hash K
 	return ParseGetPayloadResponse(rsp)
 }
 
+// ParseGetChainsResponse parses an HTTP response from a GetChainsWithResponse call
+func ParseGetChainsResponse(rsp *http.Response) (*GetChainsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetChainsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetChainsResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetChainResponse parses an HTTP response from a GetChainWithResponse call
+func ParseGetChainResponse(rsp *http.Response) (*GetChainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetChainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetChainResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePublishEventsResponse parses an HTTP response from a PublishEventsWithResponse call
 func ParsePublishEventsResponse(rsp *http.Response) (*PublishEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1095,6 +1920,46 @@ func ParsePublishEventsResponse(rsp *http.Response) (*PublishEventsResponse, err
 	return response, nil
 }
 
+// ParseGetEventResponse parses an HTTP response from a GetEventWithResponse call
+func ParseGetEventResponse(rsp *http.Response) (*GetEventResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetEventResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetTasksResponse parses an HTTP response from a GetTasksWithResponse call
 func ParseGetTasksResponse(rsp *http.Response) (*GetTasksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1135,6 +2000,39 @@ func ParseGetTasksResponse(rsp *http.Response) (*GetTasksResponse, error) {
 	return response, nil
 }
 
+// ParseStreamTasksResponse parses an HTTP response from a StreamTasksWithResponse call
+func ParseStreamTasksResponse(rsp *http.Response) (*StreamTasksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamTasksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetTaskResponse parses an HTTP response from a GetTaskWithResponse call
 func ParseGetTaskResponse(rsp *http.Response) (*GetTaskResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1175,6 +2073,100 @@ func ParseGetTaskResponse(rsp *http.Response) (*GetTaskResponse, error) {
 	return response, nil
 }
 
+// ParseAckTaskResponse parses an HTTP response from a AckTaskWithResponse call
+func ParseAckTaskResponse(rsp *http.Response) (*AckTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AckTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBatchGetTasksResponse parses an HTTP response from a BatchGetTasksWithResponse call
+func ParseBatchGetTasksResponse(rsp *http.Response) (*BatchGetTasksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BatchGetTasksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchGetTasksResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseBroadcastMsgExecuteContractResponse parses an HTTP response from a BroadcastMsgExecuteContractWithResponse call
 func ParseBroadcastMsgExecuteContractResponse(rsp *http.Response) (*BroadcastMsgExecuteContractResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1318,6 +2310,46 @@ func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error)
 	return response, nil
 }
 
+// ParseGetMessageStatusResponse parses an HTTP response from a GetMessageStatusWithResponse call
+func ParseGetMessageStatusResponse(rsp *http.Response) (*GetMessageStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMessageStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetMessageStatusResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseStorePayloadResponse parses an HTTP response from a StorePayloadWithResponse call
 func ParseStorePayloadResponse(rsp *http.Response) (*StorePayloadResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
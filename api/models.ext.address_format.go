@@ -0,0 +1,17 @@
+package api
+
+// IsKnown returns true if the AddressFormat is declared in the schema this package was generated from.
+// Backend may return formats introduced after the schema, e.g. for newly integrated chains.
+func (f AddressFormat) IsKnown() bool {
+	switch f {
+	case
+		AddressFormatBase58,
+		AddressFormatEIP55,
+		AddressFormatStarknet,
+		AddressFormatStellar,
+		AddressFormatSui:
+		return true
+	default:
+		return false
+	}
+}
@@ -130,3 +130,28 @@ func TestCost_Validate(t *testing.T) {
 		require.Error(t, result)
 	})
 }
+
+func FuzzCost(f *testing.F) {
+	for _, seed := range []string{
+		`null`, `{}`, `[]`, `"1"`, `{"amount":"1"}`, `{"amount":1}`, `{"tokenID":"a","amount":"1"}`,
+		`[{"id":"a","token":{"amount":"1"}}]`,
+		`[{"id":"a","token":{"amount":"1"}},{"id":"a","token":{"amount":"2"}}]`,
+		`[{"id":"a","token":{"amount":"1"},"meta":{"txID":"0x1"}},null]`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var cost api.Cost
+		if err := json.Unmarshal(data, &cost); err != nil {
+			_ = cost.Validate()
+			return
+		}
+
+		validateErr := cost.Validate()
+
+		var decoded api.Cost
+		require.NoError(t, json.Unmarshal(funcs.Must(json.Marshal(cost)), &decoded))
+		assert.Equal(t, validateErr == nil, decoded.Validate() == nil)
+	})
+}
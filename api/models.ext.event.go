@@ -1,24 +1,42 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
-
-	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"fmt"
 )
 
-// EventID returns id of the underlying event.
-// The codegen library doesn't provide a way to access this field out of the box.
+// ErrEmptyEvent is an error when an event holds no data, e.g. because it was decoded from JSON null.
+// The generated MarshalJSON can't encode such events, so they must be rejected before they're marshaled.
+var ErrEmptyEvent = errors.New("event is empty")
+
+// EventID returns id of the underlying event, or an empty string if the event is malformed.
+// Use GetEventID to tell a missing id from a malformed event.
 //
 //goland:noinspection GoMixedReceiverTypes
 func (e *Event) EventID() string {
+	eventID, _ := e.GetEventID()
+	return eventID
+}
+
+// GetEventID returns id of the underlying event.
+// The codegen library doesn't provide a way to access this field out of the box.
+//
+//goland:noinspection GoMixedReceiverTypes
+func (e *Event) GetEventID() (string, error) {
+	if len(e.union) == 0 || bytes.Equal(e.union, []byte("null")) {
+		return "", ErrEmptyEvent
+	}
+
 	var obj struct {
 		EventID string `json:"eventID"`
 	}
-	funcs.MustNoErr(
-		json.Unmarshal(e.union, &obj),
-	)
-	return obj.EventID
+	if err := json.Unmarshal(e.union, &obj); err != nil {
+		return "", fmt.Errorf("failed to get eventID: %w", err)
+	}
+
+	return obj.EventID, nil
 }
 
 // Validate returns error if Event isn't valid
@@ -2,6 +2,7 @@ package api_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
 	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
 )
 
@@ -31,6 +33,15 @@ func TestEvent_EventID(t *testing.T) {
 	assert.Equal(t, eventID, result)
 }
 
+func TestEvent_GetEventID_WhenNull(t *testing.T) {
+	var event api.Event
+	require.NoError(t, json.Unmarshal([]byte(`null`), &event))
+
+	_, err := event.GetEventID()
+	assert.ErrorIs(t, err, api.ErrEmptyEvent)
+	assert.Empty(t, event.EventID())
+}
+
 func TestEvent_Validate_WhenValid(t *testing.T) {
 	var validTokenCost, validFeesCost api.Cost
 
@@ -188,3 +199,80 @@ func TestEvent_Validate_WhenInvalid(t *testing.T) {
 		})
 	}
 }
+
+func TestEvent_GetEventID(t *testing.T) {
+	t.Run("should return error when event is empty", func(t *testing.T) {
+		var event api.Event
+
+		_, err := event.GetEventID()
+		assert.Error(t, err)
+		assert.Empty(t, event.EventID())
+	})
+
+	t.Run("should return error when eventID isn't a string", func(t *testing.T) {
+		var event api.Event
+		require.NoError(t, json.Unmarshal([]byte(`{"type":"CALL","eventID":5}`), &event))
+
+		_, err := event.GetEventID()
+		assert.Error(t, err)
+		assert.Empty(t, event.EventID())
+	})
+
+	t.Run("should return eventID", func(t *testing.T) {
+		event := apitest.CallEvent().With(func(e *api.CallEvent) {
+			e.EventID = "event-id"
+		}).Build()
+
+		eventID, err := event.GetEventID()
+		assert.NoError(t, err)
+		assert.Equal(t, "event-id", eventID)
+	})
+}
+
+func FuzzEvent(f *testing.F) {
+	for seed := range int64(50) {
+		f.Add(funcs.Must(json.Marshal(apitest.EventFromSeed(seed))))
+	}
+	for _, seed := range []string{
+		`null`, `{}`, `[]`, `"CALL"`, `{"type":5}`, `{"eventID":5}`,
+		`{"type":"GAS_REFUNDED","eventID":"1"}`,
+		`{"type":"MESSAGE_APPROVED","eventID":"1","cost":[]}`,
+		`{"type":"MESSAGE_EXECUTED","eventID":"1","cost":{"amount":1}}`,
+		`{"type":"CANNOT_EXECUTE_TASK","eventID":"1","cost":[{"id":"a","token":{"amount":"1"}},{"id":"a","token":{"amount":"1"}}]}`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var event api.Event
+		if err := json.Unmarshal(data, &event); err != nil {
+			// a partially decoded event must still be safe to inspect
+			_, _ = event.GetEventID()
+			_ = event.EventID()
+			_ = event.Validate()
+			return
+		}
+
+		eventID, getEventIDErr := event.GetEventID()
+		assert.Equal(t, eventID, event.EventID())
+		validateErr := event.Validate()
+
+		if errors.Is(getEventIDErr, api.ErrEmptyEvent) {
+			return
+		}
+
+		encoded, err := json.Marshal(event)
+		if err != nil {
+			return
+		}
+
+		var decoded api.Event
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		assert.Equal(t, event.Type, decoded.Type)
+
+		decodedEventID, err := decoded.GetEventID()
+		assert.Equal(t, getEventIDErr == nil, err == nil)
+		assert.Equal(t, eventID, decodedEventID)
+		assert.Equal(t, validateErr == nil, decoded.Validate() == nil)
+	})
+}
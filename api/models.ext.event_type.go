@@ -0,0 +1,28 @@
+package api
+
+// IsKnown returns true if the EventType is declared in the schema this package was generated from
+func (e EventType) IsKnown() bool {
+	switch e {
+	case
+		EventTypeAppInterchainTransferReceived,
+		EventTypeAppInterchainTransferSent,
+		EventTypeCall,
+		EventTypeCannotExecuteMessage,
+		EventTypeCannotExecuteMessageV2,
+		EventTypeCannotExecuteTask,
+		EventTypeCannotRouteMessage,
+		EventTypeGasCredit,
+		EventTypeGasRefunded,
+		EventTypeITSInterchainTokenDeploymentStarted,
+		EventTypeITSInterchainTransfer,
+		EventTypeITSLinkTokenStarted,
+		EventTypeITSTokenMetadataRegistered,
+		EventTypeMessageApproved,
+		EventTypeMessageExecuted,
+		EventTypeMessageExecutedV2,
+		EventTypeSignersRotated:
+		return true
+	default:
+		return false
+	}
+}
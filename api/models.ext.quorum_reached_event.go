@@ -0,0 +1,151 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrUnknownQuorumReachedContent is an error when QuorumReachedEvent.Content doesn't match any known shape
+var ErrUnknownQuorumReachedContent = errors.New("unknown quorum reached content")
+
+// QuorumReachedContentKind identifies a shape of QuorumReachedEvent.Content
+type QuorumReachedContentKind string
+
+// Content kinds emitted by the reference voting verifier
+// https://github.com/axelarnetwork/axelar-amplifier/blob/main/contracts/voting-verifier/src/events.rs
+const (
+	QuorumReachedContentMessage     QuorumReachedContentKind = "MESSAGE"
+	QuorumReachedContentVerifierSet QuorumReachedContentKind = "VERIFIER_SET"
+)
+
+// QuorumReachedContentDecoder decodes QuorumReachedEvent.Content of a particular shape.
+// It must return an error if the content doesn't match the shape, so that other decoders can be tried.
+type QuorumReachedContentDecoder func(content json.RawMessage) (any, error)
+
+// QuorumReachedContentShape is a chain-specific shape of QuorumReachedEvent.Content, see DecodeContent
+type QuorumReachedContentShape struct {
+	Kind   QuorumReachedContentKind
+	Decode QuorumReachedContentDecoder
+}
+
+// WasmCrossChainID is the CrossChainId as serialized by Amplifier contracts
+type WasmCrossChainID struct {
+	SourceChain string `json:"source_chain"`
+	MessageID   string `json:"message_id"`
+}
+
+// WasmMessage is the Message as serialized by Amplifier contracts
+type WasmMessage struct {
+	CcID               WasmCrossChainID `json:"cc_id"`
+	SourceAddress      string           `json:"source_address"`
+	DestinationChain   string           `json:"destination_chain"`
+	DestinationAddress string           `json:"destination_address"`
+	// PayloadHash is hex-encoded without the 0x prefix
+	PayloadHash string `json:"payload_hash"`
+}
+
+// WasmPublicKey is the PublicKey as serialized by Amplifier contracts. Exactly one of the fields is set.
+type WasmPublicKey struct {
+	Ecdsa   *string `json:"ecdsa,omitempty"`
+	Ed25519 *string `json:"ed25519,omitempty"`
+}
+
+// WasmSigner is a member of WasmVerifierSet
+type WasmSigner struct {
+	Address string        `json:"address"`
+	Weight  string        `json:"weight"`
+	PubKey  WasmPublicKey `json:"pub_key"`
+}
+
+// WasmVerifierSet is the VerifierSet as serialized by Amplifier contracts
+type WasmVerifierSet struct {
+	Signers   map[string]WasmSigner `json:"signers"`
+	Threshold string                `json:"threshold"`
+	CreatedAt uint64                `json:"created_at"`
+}
+
+// WasmVerifierSetConfirmation is the content of a quorum reached on a verifier set poll
+type WasmVerifierSetConfirmation struct {
+	MessageID   string          `json:"message_id"`
+	VerifierSet WasmVerifierSet `json:"verifier_set"`
+}
+
+// CrossChainID returns the API representation of WasmMessage.CcID
+func (m WasmMessage) CrossChainID() CrossChainID {
+	return CrossChainID{
+		SourceChain: m.CcID.SourceChain,
+		MessageID:   m.CcID.MessageID,
+	}
+}
+
+// AsMessage returns QuorumReachedEvent.Content as a message poll content
+func (e QuorumReachedEvent) AsMessage() (WasmMessage, error) {
+	var msg WasmMessage
+	if err := json.Unmarshal(e.Content, &msg); err != nil {
+		return WasmMessage{}, err
+	}
+
+	if msg.CcID.SourceChain == "" || msg.CcID.MessageID == "" || msg.PayloadHash == "" {
+		return WasmMessage{}, errors.New("content is missing message fields")
+	}
+
+	return msg, nil
+}
+
+// AsVerifierSetConfirmation returns QuorumReachedEvent.Content as a verifier set poll content
+func (e QuorumReachedEvent) AsVerifierSetConfirmation() (WasmVerifierSetConfirmation, error) {
+	var confirmation WasmVerifierSetConfirmation
+	if err := json.Unmarshal(e.Content, &confirmation); err != nil {
+		return WasmVerifierSetConfirmation{}, err
+	}
+
+	if confirmation.MessageID == "" || confirmation.VerifierSet.Signers == nil {
+		return WasmVerifierSetConfirmation{}, errors.New("content is missing verifier set fields")
+	}
+
+	return confirmation, nil
+}
+
+// DecodeContent detects the shape of QuorumReachedEvent.Content and decodes it.
+// The given chain-specific shapes are tried first, in order,
+// then the built-in ones which return WasmMessage and WasmVerifierSetConfirmation respectively.
+// Fields unknown to a shape are ignored, so that contracts can add fields without breaking decoding.
+func (e QuorumReachedEvent) DecodeContent(shapes ...QuorumReachedContentShape) (QuorumReachedContentKind, any, error) {
+	shapes = append(slices.Clip(shapes),
+		QuorumReachedContentShape{
+			Kind: QuorumReachedContentMessage,
+			Decode: func(json.RawMessage) (any, error) {
+				return e.AsMessage()
+			},
+		},
+		QuorumReachedContentShape{
+			Kind: QuorumReachedContentVerifierSet,
+			Decode: func(json.RawMessage) (any, error) {
+				return e.AsVerifierSetConfirmation()
+			},
+		},
+	)
+
+	errs := make([]error, 0, len(shapes))
+	for _, shape := range shapes {
+		content, err := shape.Decode(e.Content)
+		if err == nil {
+			return shape.Kind, content, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", shape.Kind, err))
+	}
+
+	return "", nil, fmt.Errorf("%w: %w", ErrUnknownQuorumReachedContent, errors.Join(errs...))
+}
+
+// QuorumReachedEventsByStatus groups ReactToRetriablePollTask.QuorumReachedEvents by their status preserving the order
+func (t ReactToRetriablePollTask) QuorumReachedEventsByStatus() map[VerificationStatus][]QuorumReachedEvent {
+	grouped := make(map[VerificationStatus][]QuorumReachedEvent)
+	for _, event := range t.QuorumReachedEvents {
+		grouped[event.Status] = append(grouped[event.Status], event)
+	}
+
+	return grouped
+}
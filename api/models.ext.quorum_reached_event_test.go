@@ -0,0 +1,154 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+const messageContentJSON = `
+{
+	"cc_id": {
+		"source_chain": "ethereum",
+		"message_id": "0x1a2b-0"
+	},
+	"source_address": "0xsource",
+	"destination_chain": "axelar",
+	"destination_address": "axelar1destination",
+	"payload_hash": "7f9fade1c0d57a7af66ab4ead79fade1c0d57a7af66ab4ead7c2c2eb7b11a91385"
+}
+`
+
+const verifierSetContentJSON = `
+{
+	"message_id": "0x1a2b-1",
+	"verifier_set": {
+		"signers": {
+			"axelar1signer": {
+				"address": "axelar1signer",
+				"weight": "1",
+				"pub_key": {
+					"ecdsa": "02abcdef"
+				}
+			}
+		},
+		"threshold": "1",
+		"created_at": 123
+	}
+}
+`
+
+func TestQuorumReachedEvent_DecodeContent(t *testing.T) {
+	t.Run("when content is message", func(t *testing.T) {
+		event := api.QuorumReachedEvent{
+			Status:  api.VerificationStatusSucceededOnSourceChain,
+			Content: json.RawMessage(messageContentJSON),
+		}
+
+		kind, content, err := event.DecodeContent()
+		require.NoError(t, err)
+		assert.Equal(t, api.QuorumReachedContentMessage, kind)
+
+		msg, ok := content.(api.WasmMessage)
+		require.True(t, ok)
+		assert.Equal(t, api.CrossChainID{SourceChain: "ethereum", MessageID: "0x1a2b-0"}, msg.CrossChainID())
+
+		_, err = event.AsVerifierSetConfirmation()
+		assert.Error(t, err)
+	})
+
+	t.Run("when content is verifier set", func(t *testing.T) {
+		event := api.QuorumReachedEvent{
+			Status:  api.VerificationStatusSucceededOnSourceChain,
+			Content: json.RawMessage(verifierSetContentJSON),
+		}
+
+		kind, content, err := event.DecodeContent()
+		require.NoError(t, err)
+		assert.Equal(t, api.QuorumReachedContentVerifierSet, kind)
+
+		confirmation, ok := content.(api.WasmVerifierSetConfirmation)
+		require.True(t, ok)
+		assert.Equal(t, "0x1a2b-1", confirmation.MessageID)
+		assert.Equal(t, uint64(123), confirmation.VerifierSet.CreatedAt)
+		assert.Contains(t, confirmation.VerifierSet.Signers, "axelar1signer")
+
+		_, err = event.AsMessage()
+		assert.Error(t, err)
+	})
+
+	t.Run("when content is unknown", func(t *testing.T) {
+		event := api.QuorumReachedEvent{
+			Status:  api.VerificationStatusUnknown,
+			Content: json.RawMessage(`{"unknown": true}`),
+		}
+
+		_, _, err := event.DecodeContent()
+		assert.ErrorIs(t, err, api.ErrUnknownQuorumReachedContent)
+	})
+
+	t.Run("when content has fields unknown to the shape", func(t *testing.T) {
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal([]byte(messageContentJSON), &fields))
+		fields["new_field"] = "value"
+
+		kind, _, err := api.QuorumReachedEvent{Content: json.RawMessage(funcs.Must(json.Marshal(fields)))}.DecodeContent()
+		require.NoError(t, err)
+		assert.Equal(t, api.QuorumReachedContentMessage, kind)
+	})
+
+	t.Run("when chain-specific shape is given", func(t *testing.T) {
+		type customContent struct {
+			Ledger uint64 `json:"ledger"`
+		}
+
+		ledger := api.QuorumReachedContentShape{
+			Kind: "TEST_CUSTOM_LEDGER",
+			Decode: func(content json.RawMessage) (any, error) {
+				var obj customContent
+				if err := json.Unmarshal(content, &obj); err != nil {
+					return nil, err
+				}
+				if obj.Ledger == 0 {
+					return nil, errors.New("not a ledger content")
+				}
+				return obj, nil
+			},
+		}
+
+		kind, content, err := api.QuorumReachedEvent{Content: json.RawMessage(`{"ledger": 5}`)}.DecodeContent(ledger)
+		require.NoError(t, err)
+		assert.Equal(t, ledger.Kind, kind)
+		assert.Equal(t, customContent{Ledger: 5}, content)
+
+		kind, _, err = api.QuorumReachedEvent{Content: json.RawMessage(messageContentJSON)}.DecodeContent(ledger)
+		require.NoError(t, err)
+		assert.Equal(t, api.QuorumReachedContentMessage, kind)
+
+		_, _, err = api.QuorumReachedEvent{Content: json.RawMessage(`{"ledger": 5}`)}.DecodeContent()
+		assert.ErrorIs(t, err, api.ErrUnknownQuorumReachedContent, "shapes must only apply to the call they're given to")
+	})
+}
+
+func TestReactToRetriablePollTask_QuorumReachedEventsByStatus(t *testing.T) {
+	task := api.ReactToRetriablePollTask{
+		QuorumReachedEvents: []api.QuorumReachedEvent{
+			{Status: api.VerificationStatusSucceededOnSourceChain, Content: json.RawMessage(`1`)},
+			{Status: api.VerificationStatusNotFoundOnSourceChain, Content: json.RawMessage(`2`)},
+			{Status: api.VerificationStatusSucceededOnSourceChain, Content: json.RawMessage(`3`)},
+		},
+	}
+
+	grouped := task.QuorumReachedEventsByStatus()
+
+	require.Len(t, grouped, 2)
+	require.Len(t, grouped[api.VerificationStatusSucceededOnSourceChain], 2)
+	assert.Equal(t, json.RawMessage(`3`), grouped[api.VerificationStatusSucceededOnSourceChain][1].Content)
+	assert.Len(t, grouped[api.VerificationStatusNotFoundOnSourceChain], 1)
+}
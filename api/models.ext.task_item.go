@@ -51,6 +51,12 @@ func (t *TaskItem) SetTaskFromJSON(taskType TaskType, taskJSON string) error {
 	return nil
 }
 
+// RawTask returns JSON of the task as received. It's the only way to access tasks of types unknown to this package,
+// see TaskType.IsKnown.
+func (t *TaskItem) RawTask() (json.RawMessage, error) {
+	return t.Task.MarshalJSON()
+}
+
 func setTaskFromJSONWithSetter[T any](taskJSON string, taskSetter func(T) error) error {
 	var task T
 	if err := json.Unmarshal([]byte(taskJSON), &task); err != nil {
@@ -0,0 +1,106 @@
+package api_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/schemadiff"
+)
+
+func TestTaskItem_SetTaskFromJSON(t *testing.T) {
+	t.Run("should return error when task type is unknown", func(t *testing.T) {
+		var item api.TaskItem
+		assert.ErrorIs(t, item.SetTaskFromJSON("UNKNOWN", `{}`), api.ErrUnknownTaskType)
+	})
+
+	t.Run("should return error when task doesn't match the type", func(t *testing.T) {
+		var item api.TaskItem
+		assert.Error(t, item.SetTaskFromJSON(api.TaskTypeExecute, `{"message":[]}`))
+	})
+}
+
+func TestTaskItem_RawTask(t *testing.T) {
+	t.Run("should keep task of unknown type", func(t *testing.T) {
+		var item api.TaskItem
+		require.NoError(t, json.Unmarshal([]byte(`{
+			"id": "00000000-0000-0000-0000-000000000001",
+			"chain": "ethereum",
+			"timestamp": "2025-01-01T00:00:00Z",
+			"type": "NEW_TASK",
+			"task": {"field": [1, 2]}
+		}`), &item))
+
+		assert.False(t, item.Type.IsKnown())
+		assert.JSONEq(t, `{"field": [1, 2]}`, string(funcs.Must(item.RawTask())))
+	})
+
+	t.Run("should return task of known type", func(t *testing.T) {
+		item := apitest.VerifyTask().Build()
+
+		assert.True(t, item.Type.IsKnown())
+		assert.JSONEq(t, string(funcs.Must(json.Marshal(item.Task))), string(funcs.Must(item.RawTask())))
+	})
+}
+
+// TestIsKnown reads schema.yaml rather than the embedded spec, so that it also fails if a value is added without regenerating the code
+func TestIsKnown(t *testing.T) {
+	schemas := funcs.Must(schemadiff.Load(funcs.Must(os.ReadFile("../schema/schema.yaml")))).Components.Schemas
+
+	for _, value := range schemas["TaskType"].Value.Enum {
+		assert.True(t, api.TaskType(value.(string)).IsKnown(), value)
+	}
+	for _, value := range schemas["EventType"].Value.Enum {
+		assert.True(t, api.EventType(value.(string)).IsKnown(), value)
+	}
+	for _, value := range schemas["AddressFormat"].Value.Enum {
+		assert.True(t, api.AddressFormat(value.(string)).IsKnown(), value)
+	}
+
+	assert.False(t, api.TaskType("NEW_TASK").IsKnown())
+	assert.False(t, api.EventType("NEW_EVENT").IsKnown())
+	assert.False(t, api.AddressFormat("NEW_FORMAT").IsKnown())
+}
+
+func FuzzTaskItem(f *testing.F) {
+	for seed := range int64(50) {
+		f.Add(funcs.Must(json.Marshal(apitest.TaskItemFromSeed(seed))))
+	}
+	for _, seed := range []string{
+		`null`, `{}`, `[]`,
+		`{"id":"00000000-0000-0000-0000-000000000000","chain":"c","timestamp":"2025-01-01T00:00:00Z","type":"EXECUTE","task":null}`,
+		`{"id":"00000000-0000-0000-0000-000000000000","chain":"c","timestamp":"2025-01-01T00:00:00Z","type":"UNKNOWN","task":{"a":1}}`,
+		`{"id":"00000000-0000-0000-0000-000000000000","chain":"c","timestamp":"2025-01-01T00:00:00Z","type":"VERIFY","task":"str"}`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var item api.TaskItem
+		if err := json.Unmarshal(data, &item); err != nil {
+			return
+		}
+
+		taskJSON, err := json.Marshal(item.Task)
+		require.NoError(t, err)
+
+		var rebuilt api.TaskItem
+		_ = rebuilt.SetTaskFromJSON(item.Type, string(taskJSON))
+
+		encoded, err := json.Marshal(item)
+		if err != nil {
+			// e.g. timestamps outside of the range supported by RFC 3339
+			return
+		}
+
+		var decoded api.TaskItem
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		assert.Equal(t, string(encoded), string(funcs.Must(json.Marshal(decoded))))
+	})
+}
@@ -0,0 +1,20 @@
+package api
+
+// IsKnown returns true if the TaskType is declared in the schema this package was generated from.
+// Backend may send task types introduced after the schema, relayers should be prepared to skip them.
+func (t TaskType) IsKnown() bool {
+	switch t {
+	case
+		TaskTypeConstructProof,
+		TaskTypeExecute,
+		TaskTypeGatewayTransaction,
+		TaskTypeReactToExpiredSigningSession,
+		TaskTypeReactToRetriablePoll,
+		TaskTypeReactToWasmEvent,
+		TaskTypeRefund,
+		TaskTypeVerify:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// Messages of the reference Amplifier contracts
+// https://github.com/axelarnetwork/axelar-amplifier/tree/main/contracts
+// Pass them to WasmRequestFromObject to build a request for BroadcastMsgExecuteContract or QueryContractState.
+
+// GatewayVerifyMessages is the gateway's verify_messages execute message
+type GatewayVerifyMessages struct {
+	VerifyMessages []WasmMessage `json:"verify_messages"`
+}
+
+// GatewayRouteMessages is the gateway's route_messages execute message
+type GatewayRouteMessages struct {
+	RouteMessages []WasmMessage `json:"route_messages"`
+}
+
+// ProverConstructProof is the multisig prover's construct_proof execute message
+type ProverConstructProof struct {
+	ConstructProof []WasmCrossChainID `json:"construct_proof"`
+}
+
+// VotingVerifierMessagesStatus is the voting verifier's messages_status query.
+// The response is a list of WasmMessageStatus.
+type VotingVerifierMessagesStatus struct {
+	MessagesStatus []WasmMessage `json:"messages_status"`
+}
+
+// WasmVerificationStatus is the VerificationStatus as serialized by Amplifier contracts, e.g. "succeeded_on_source_chain"
+type WasmVerificationStatus string
+
+// WasmMessageStatus is an item of the voting verifier's messages_status query response
+type WasmMessageStatus struct {
+	Message WasmMessage            `json:"message"`
+	Status  WasmVerificationStatus `json:"status"`
+}
+
+// VerificationStatus converts WasmVerificationStatus to the API VerificationStatus
+func (s WasmVerificationStatus) VerificationStatus() VerificationStatus {
+	return VerificationStatus(strings.ToUpper(string(s)))
+}
+
+// WasmCrossChainIDFromCrossChainID converts the API CrossChainID to WasmCrossChainID
+func WasmCrossChainIDFromCrossChainID(id CrossChainID) WasmCrossChainID {
+	return WasmCrossChainID{
+		SourceChain: id.SourceChain,
+		MessageID:   id.MessageID,
+	}
+}
+
+// WasmMessageFromMessage converts the API Message to WasmMessage. The API Message doesn't carry the destination chain,
+// so it has to be provided explicitly.
+func WasmMessageFromMessage(msg Message, destinationChain string) WasmMessage {
+	return WasmMessage{
+		CcID: WasmCrossChainID{
+			SourceChain: msg.SourceChain,
+			MessageID:   msg.MessageID,
+		},
+		SourceAddress:      msg.SourceAddress,
+		DestinationChain:   destinationChain,
+		DestinationAddress: msg.DestinationAddress,
+		PayloadHash:        hex.EncodeToString(msg.PayloadHash),
+	}
+}
+
+// WasmMessage returns the message of the task as WasmMessage, e.g. to be included in GatewayVerifyMessages
+func (t VerifyTask) WasmMessage() WasmMessage {
+	return WasmMessageFromMessage(t.Message, t.DestinationChain)
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrWasmEventAttributeNotFound is an error when WasmEvent doesn't have an attribute with the requested key
+var ErrWasmEventAttributeNotFound = errors.New("wasm event attribute not found")
+
+// wasmTagName is the struct tag used by WasmEvent.Decode and EncodeWasmEvent, e.g. `wasm:"message_id"`.
+// Appending ",optional" to the key allows the attribute to be missing when decoding and skips zero values when encoding.
+const wasmTagName = "wasm"
+
+// Attribute returns the value of the first attribute with the given key
+func (e WasmEvent) Attribute(key string) (string, bool) {
+	for _, attr := range e.Attributes {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// AttributeValues returns values of all attributes with the given key in the order they appear in the event
+func (e WasmEvent) AttributeValues(key string) []string {
+	var values []string
+	for _, attr := range e.Attributes {
+		if attr.Key == key {
+			values = append(values, attr.Value)
+		}
+	}
+
+	return values
+}
+
+// UnmarshalAttribute decodes the value of the first attribute with the given key into v.
+// Amplifier contracts JSON-encode attribute values, so the value is unmarshalled as JSON.
+// If v points to a string and the value isn't a JSON string, the raw value is assigned as is.
+func (e WasmEvent) UnmarshalAttribute(key string, v any) error {
+	value, ok := e.Attribute(key)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrWasmEventAttributeNotFound, key)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("expected a non-nil pointer, got %T", v)
+	}
+
+	if err := decodeWasmAttributeValue(value, rv.Elem()); err != nil {
+		return fmt.Errorf("failed to decode attribute %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Decode fills fields of the struct pointed to by v from the event attributes.
+// Fields are matched by the `wasm:"key"` tag, fields without the tag are ignored.
+// Attributes are required unless the tag has the ",optional" option.
+func (e WasmEvent) Decode(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a non-nil pointer to a struct, got %T", v)
+	}
+
+	rv = rv.Elem()
+	for i := range rv.NumField() {
+		key, optional, ok := parseWasmTag(rv.Type().Field(i))
+		if !ok {
+			continue
+		}
+
+		value, found := e.Attribute(key)
+		if !found {
+			if optional {
+				continue
+			}
+			return fmt.Errorf("%w: %s", ErrWasmEventAttributeNotFound, key)
+		}
+
+		if err := decodeWasmAttributeValue(value, rv.Field(i)); err != nil {
+			return fmt.Errorf("failed to decode attribute %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// EncodeWasmEvent creates a WasmEvent of the given type from a struct with `wasm:"key"` tags.
+// It's the inverse of WasmEvent.Decode: values are JSON-encoded the same way Amplifier contracts encode them.
+// Primarily useful for building fixtures in tests.
+func EncodeWasmEvent(eventType string, v any) (WasmEvent, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return WasmEvent{}, fmt.Errorf("expected a struct, got %T", v)
+	}
+
+	event := WasmEvent{
+		Type:       eventType,
+		Attributes: []WasmEventAttribute{},
+	}
+
+	for i := range rv.NumField() {
+		key, optional, ok := parseWasmTag(rv.Type().Field(i))
+		if !ok {
+			continue
+		}
+
+		field := rv.Field(i)
+		if optional && field.IsZero() {
+			continue
+		}
+
+		value, err := json.Marshal(field.Interface())
+		if err != nil {
+			return WasmEvent{}, fmt.Errorf("failed to encode attribute %s: %w", key, err)
+		}
+
+		event.Attributes = append(event.Attributes, WasmEventAttribute{
+			Key:   key,
+			Value: string(value),
+		})
+	}
+
+	return event, nil
+}
+
+// WasmEventsOfType returns tx events of the given type, e.g. "wasm-quorum_reached"
+func (r BroadcastStatusResponse) WasmEventsOfType(eventType string) []WasmEvent {
+	if r.TxEvents == nil {
+		return nil
+	}
+
+	var events []WasmEvent
+	for _, event := range *r.TxEvents {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+func parseWasmTag(field reflect.StructField) (key string, optional bool, ok bool) {
+	if !field.IsExported() {
+		return "", false, false
+	}
+
+	tag, found := field.Tag.Lookup(wasmTagName)
+	if !found || tag == "-" {
+		return "", false, false
+	}
+
+	key, opts, _ := strings.Cut(tag, ",")
+	if key == "" {
+		return "", false, false
+	}
+
+	return key, opts == "optional", true
+}
+
+func decodeWasmAttributeValue(value string, target reflect.Value) error {
+	ptr := target.Addr().Interface()
+
+	err := json.Unmarshal([]byte(value), ptr)
+	if err == nil {
+		return nil
+	}
+
+	// values that aren't valid JSON are accepted as is for string fields
+	if target.Kind() == reflect.String {
+		target.SetString(value)
+		return nil
+	}
+
+	// some contracts encode numbers as JSON strings, e.g. "\"42\""
+	var unquoted string
+	if json.Unmarshal([]byte(value), &unquoted) == nil {
+		if innerErr := json.Unmarshal([]byte(unquoted), ptr); innerErr == nil {
+			return nil
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,150 @@
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+type quorumReachedWasmEvent struct {
+	Content json.RawMessage        `wasm:"content"`
+	Status  api.VerificationStatus `wasm:"status"`
+	PollID  uint64                 `wasm:"poll_id"`
+	Source  string                 `wasm:"source_chain,optional"`
+	Ignored string
+}
+
+func TestWasmEvent_Attribute(t *testing.T) {
+	event := api.WasmEvent{
+		Type: "wasm-quorum_reached",
+		Attributes: []api.WasmEventAttribute{
+			{Key: "poll_id", Value: `"1"`},
+			{Key: "tag", Value: "a"},
+			{Key: "tag", Value: "b"},
+		},
+	}
+
+	t.Run("should return first value", func(t *testing.T) {
+		value, ok := event.Attribute("tag")
+		assert.True(t, ok)
+		assert.Equal(t, "a", value)
+	})
+
+	t.Run("should report missing attribute", func(t *testing.T) {
+		_, ok := event.Attribute("missing")
+		assert.False(t, ok)
+	})
+
+	t.Run("should return all values", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b"}, event.AttributeValues("tag"))
+	})
+
+	t.Run("should unmarshal JSON-encoded number", func(t *testing.T) {
+		var pollID uint64
+		require.NoError(t, event.UnmarshalAttribute("poll_id", &pollID))
+		assert.Equal(t, uint64(1), pollID)
+	})
+
+	t.Run("should unmarshal raw string", func(t *testing.T) {
+		var tag string
+		require.NoError(t, event.UnmarshalAttribute("tag", &tag))
+		assert.Equal(t, "a", tag)
+	})
+
+	t.Run("should fail when attribute is missing", func(t *testing.T) {
+		var value string
+		assert.ErrorIs(t, event.UnmarshalAttribute("missing", &value), api.ErrWasmEventAttributeNotFound)
+	})
+}
+
+func TestWasmEvent_Decode(t *testing.T) {
+	t.Run("should decode struct", func(t *testing.T) {
+		event := api.WasmEvent{
+			Type: "wasm-quorum_reached",
+			Attributes: []api.WasmEventAttribute{
+				{Key: "content", Value: `{"message_id":"0xabc-1"}`},
+				{Key: "status", Value: `"SUCCEEDED_ON_SOURCE_CHAIN"`},
+				{Key: "poll_id", Value: `"42"`},
+			},
+		}
+
+		var result quorumReachedWasmEvent
+		require.NoError(t, event.Decode(&result))
+
+		assert.JSONEq(t, `{"message_id":"0xabc-1"}`, string(result.Content))
+		assert.Equal(t, api.VerificationStatusSucceededOnSourceChain, result.Status)
+		assert.Equal(t, uint64(42), result.PollID)
+		assert.Empty(t, result.Source)
+	})
+
+	t.Run("should fail when required attribute is missing", func(t *testing.T) {
+		event := api.WasmEvent{
+			Type: "wasm-quorum_reached",
+			Attributes: []api.WasmEventAttribute{
+				{Key: "content", Value: `{}`},
+			},
+		}
+
+		var result quorumReachedWasmEvent
+		assert.ErrorIs(t, event.Decode(&result), api.ErrWasmEventAttributeNotFound)
+	})
+
+	t.Run("should fail when value cannot be decoded", func(t *testing.T) {
+		event := api.WasmEvent{
+			Type: "wasm-quorum_reached",
+			Attributes: []api.WasmEventAttribute{
+				{Key: "content", Value: `{}`},
+				{Key: "status", Value: `"UNKNOWN"`},
+				{Key: "poll_id", Value: `not a number`},
+			},
+		}
+
+		var result quorumReachedWasmEvent
+		assert.Error(t, event.Decode(&result))
+	})
+
+	t.Run("should fail when target isn't a pointer to struct", func(t *testing.T) {
+		var result quorumReachedWasmEvent
+		assert.Error(t, api.WasmEvent{}.Decode(result))
+	})
+}
+
+func TestEncodeWasmEvent(t *testing.T) {
+	original := quorumReachedWasmEvent{
+		Content: json.RawMessage(`{"message_id":"0xabc-1"}`),
+		Status:  api.VerificationStatusFailedOnSourceChain,
+		PollID:  7,
+		Ignored: "ignored",
+	}
+
+	event := funcs.Must(api.EncodeWasmEvent("wasm-quorum_reached", original))
+
+	assert.Equal(t, "wasm-quorum_reached", event.Type)
+	assert.Len(t, event.Attributes, 3)
+
+	var decoded quorumReachedWasmEvent
+	require.NoError(t, event.Decode(&decoded))
+
+	original.Ignored = ""
+	assert.Equal(t, original, decoded)
+}
+
+func TestBroadcastStatusResponse_WasmEventsOfType(t *testing.T) {
+	events := []api.WasmEvent{
+		{Type: "wasm-quorum_reached"},
+		{Type: "wasm-voted"},
+		{Type: "wasm-quorum_reached"},
+	}
+	response := api.BroadcastStatusResponse{
+		TxEvents: &events,
+	}
+
+	assert.Len(t, response.WasmEventsOfType("wasm-quorum_reached"), 2)
+	assert.Empty(t, response.WasmEventsOfType("wasm-poll_ended"))
+	assert.Empty(t, api.BroadcastStatusResponse{}.WasmEventsOfType("wasm-quorum_reached"))
+}
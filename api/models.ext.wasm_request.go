@@ -0,0 +1,76 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// WasmRequestKind identifies which variant of WasmRequest is set
+type WasmRequestKind string
+
+// Variants of WasmRequest
+const (
+	WasmRequestKindUnknown WasmRequestKind = ""
+	WasmRequestKindObject  WasmRequestKind = "OBJECT"
+	WasmRequestKindString  WasmRequestKind = "STRING"
+)
+
+// WasmRequestFromObject creates a WasmRequest with an object body from any value that marshals into a JSON object,
+// e.g. GatewayVerifyMessages or a map
+func WasmRequestFromObject(obj any) (WasmRequest, error) {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return WasmRequest{}, fmt.Errorf("failed to marshal wasm request: %w", err)
+	}
+
+	var request WasmRequest
+	if err := request.UnmarshalJSON(body); err != nil {
+		return WasmRequest{}, err
+	}
+
+	if request.Kind() != WasmRequestKindObject {
+		return WasmRequest{}, fmt.Errorf("wasm request must be a JSON object, got %T", obj)
+	}
+
+	return request, nil
+}
+
+// WasmRequestFromString creates a WasmRequest with a non-empty string body
+func WasmRequestFromString(body string) (WasmRequest, error) {
+	if body == "" {
+		return WasmRequest{}, errors.New("wasm request body cannot be empty")
+	}
+
+	var request WasmRequest
+	if err := request.FromWasmRequestWithStringBody(body); err != nil {
+		return WasmRequest{}, err
+	}
+
+	return request, nil
+}
+
+// Kind returns which variant of WasmRequest is set
+//
+//goland:noinspection GoMixedReceiverTypes
+func (t WasmRequest) Kind() WasmRequestKind {
+	body, err := t.MarshalJSON()
+	if err != nil {
+		return WasmRequestKindUnknown
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return WasmRequestKindUnknown
+	}
+
+	switch body[0] {
+	case '{':
+		return WasmRequestKindObject
+	case '"':
+		return WasmRequestKindString
+	default:
+		return WasmRequestKindUnknown
+	}
+}
@@ -0,0 +1,103 @@
+package api_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+func TestWasmRequestFromObject(t *testing.T) {
+	t.Run("when value is an object", func(t *testing.T) {
+		request, err := api.WasmRequestFromObject(api.ProverConstructProof{
+			ConstructProof: []api.WasmCrossChainID{
+				{SourceChain: "ethereum", MessageID: "0x1a2b-0"},
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, api.WasmRequestKindObject, request.Kind())
+		assert.JSONEq(t,
+			`{"construct_proof":[{"source_chain":"ethereum","message_id":"0x1a2b-0"}]}`,
+			string(funcs.Must(json.Marshal(request))),
+		)
+	})
+
+	t.Run("when value isn't an object", func(t *testing.T) {
+		_, err := api.WasmRequestFromObject([]string{"a"})
+		assert.Error(t, err)
+
+		_, err = api.WasmRequestFromObject("a")
+		assert.Error(t, err)
+	})
+}
+
+func TestWasmRequestFromString(t *testing.T) {
+	request, err := api.WasmRequestFromString("raw")
+	require.NoError(t, err)
+	assert.Equal(t, api.WasmRequestKindString, request.Kind())
+
+	body, err := request.AsWasmRequestWithStringBody()
+	require.NoError(t, err)
+	assert.Equal(t, "raw", body)
+
+	_, err = api.WasmRequestFromString("")
+	assert.Error(t, err)
+}
+
+func TestWasmRequest_Kind(t *testing.T) {
+	assert.Equal(t, api.WasmRequestKindUnknown, api.WasmRequest{}.Kind())
+
+	var request api.WasmRequest
+	funcs.MustNoErr(json.Unmarshal([]byte(` {"a": 1}`), &request))
+	assert.Equal(t, api.WasmRequestKindObject, request.Kind())
+
+	funcs.MustNoErr(json.Unmarshal([]byte(`1`), &request))
+	assert.Equal(t, api.WasmRequestKindUnknown, request.Kind())
+}
+
+func TestVerifyTask_WasmMessage(t *testing.T) {
+	task := api.VerifyTask{
+		Message: api.Message{
+			MessageID:          "0x1a2b-0",
+			SourceChain:        "ethereum",
+			SourceAddress:      "0xsource",
+			DestinationAddress: "0xdestination",
+			PayloadHash:        []byte{0xde, 0xad, 0xbe, 0xef},
+		},
+		DestinationChain: "avalanche",
+	}
+
+	request := funcs.Must(api.WasmRequestFromObject(api.GatewayVerifyMessages{
+		VerifyMessages: []api.WasmMessage{task.WasmMessage()},
+	}))
+
+	assert.JSONEq(t, `
+{
+	"verify_messages": [
+		{
+			"cc_id": {
+				"source_chain": "ethereum",
+				"message_id": "0x1a2b-0"
+			},
+			"source_address": "0xsource",
+			"destination_chain": "avalanche",
+			"destination_address": "0xdestination",
+			"payload_hash": "deadbeef"
+		}
+	]
+}
+`, string(funcs.Must(json.Marshal(request))))
+}
+
+func TestWasmVerificationStatus_VerificationStatus(t *testing.T) {
+	var statuses []api.WasmMessageStatus
+	funcs.MustNoErr(json.Unmarshal([]byte(`[{"message": {}, "status": "not_found_on_source_chain"}]`), &statuses))
+
+	require.Len(t, statuses, 1)
+	assert.Equal(t, api.VerificationStatusNotFoundOnSourceChain, statuses[0].Status.VerificationStatus())
+}
@@ -13,6 +13,15 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for AddressFormat.
+const (
+	AddressFormatBase58   AddressFormat = "BASE58"
+	AddressFormatEIP55    AddressFormat = "EIP55"
+	AddressFormatStarknet AddressFormat = "STARKNET"
+	AddressFormatStellar  AddressFormat = "STELLAR"
+	AddressFormatSui      AddressFormat = "SUI"
+)
+
 // Defines values for BroadcastStatus.
 const (
 	BroadcastStatusError    BroadcastStatus = "ERROR"
@@ -40,6 +49,13 @@ const (
 	CannotRouteMessageReasonError  CannotRouteMessageReason = "ERROR"
 )
 
+// Defines values for EventIngestionStatus.
+const (
+	EventIngestionStatusFailed   EventIngestionStatus = "FAILED"
+	EventIngestionStatusIndexed  EventIngestionStatus = "INDEXED"
+	EventIngestionStatusReceived EventIngestionStatus = "RECEIVED"
+)
+
 // Defines values for EventType.
 const (
 	EventTypeAppInterchainTransferReceived       EventType = "APP/INTERCHAIN_TRANSFER_RECEIVED"
@@ -67,12 +83,28 @@ const (
 	MessageExecutionStatusSuccessful MessageExecutionStatus = "SUCCESSFUL"
 )
 
+// Defines values for MessageStage.
+const (
+	MessageStageApproved MessageStage = "APPROVED"
+	MessageStageCalled   MessageStage = "CALLED"
+	MessageStageExecuted MessageStage = "EXECUTED"
+	MessageStageFailed   MessageStage = "FAILED"
+	MessageStageVerified MessageStage = "VERIFIED"
+)
+
 // Defines values for PublishEventStatus.
 const (
 	PublishEventStatusAccepted PublishEventStatus = "ACCEPTED"
 	PublishEventStatusError    PublishEventStatus = "ERROR"
 )
 
+// Defines values for TaskAckStatus.
+const (
+	TaskAckStatusDone       TaskAckStatus = "DONE"
+	TaskAckStatusFailed     TaskAckStatus = "FAILED"
+	TaskAckStatusProcessing TaskAckStatus = "PROCESSING"
+)
+
 // Defines values for TaskType.
 const (
 	TaskTypeConstructProof               TaskType = "CONSTRUCT_PROOF"
@@ -104,9 +136,19 @@ const (
 	VerificationStatusUnknown                VerificationStatus = "UNKNOWN"
 )
 
+// AckTaskRequest defines model for AckTaskRequest.
+type AckTaskRequest struct {
+	Details *string       `json:"details,omitempty"`
+	Status  TaskAckStatus `json:"status"`
+}
+
 // Address defines model for Address.
 type Address = string
 
+// AddressFormat Family of the address format of the chain, as configured in its voting verifier.
+// Formats may be added over time, clients should be prepared to handle values they don't know.
+type AddressFormat string
+
 // AppEventMetadata defines model for AppEventMetadata.
 type AppEventMetadata struct {
 	EmittedByAddress *Address   `json:"emittedByAddress,omitempty"`
@@ -140,6 +182,17 @@ type AppInterchainTransferSentEvent struct {
 	TokenSpent                 InterchainTransferTokenWithAddress `json:"tokenSpent"`
 }
 
+// BatchGetTasksRequest defines model for BatchGetTasksRequest.
+type BatchGetTasksRequest struct {
+	Ids []TaskItemID `json:"ids"`
+}
+
+// BatchGetTasksResult defines model for BatchGetTasksResult.
+type BatchGetTasksResult struct {
+	Missing []TaskItemID `json:"missing"`
+	Tasks   []TaskItem   `json:"tasks"`
+}
+
 // BigInt defines model for BigInt.
 type BigInt = string
 
@@ -249,12 +302,32 @@ type CannotRouteMessageEvent struct {
 // CannotRouteMessageReason defines model for CannotRouteMessageReason.
 type CannotRouteMessageReason string
 
+// ChainInfo defines model for ChainInfo.
+type ChainInfo struct {
+	// AddressFormat Family of the address format of the chain, as configured in its voting verifier.
+	// Formats may be added over time, clients should be prepared to handle values they don't know.
+	AddressFormat AddressFormat `json:"addressFormat"`
+
+	// Gateway Address of a CosmWasm contract on Axelar
+	Gateway ContractAddress `json:"gateway"`
+
+	// MultisigProver Address of a CosmWasm contract on Axelar
+	MultisigProver ContractAddress `json:"multisigProver"`
+	Name           string          `json:"name"`
+
+	// VotingVerifier Address of a CosmWasm contract on Axelar
+	VotingVerifier ContractAddress `json:"votingVerifier"`
+}
+
 // ConstructProofTask defines model for ConstructProofTask.
 type ConstructProofTask struct {
 	Message Message `json:"message"`
 	Payload []byte  `json:"payload"`
 }
 
+// ContractAddress Address of a CosmWasm contract on Axelar
+type ContractAddress = string
+
 // ContractQueryResponse defines model for ContractQueryResponse.
 type ContractQueryResponse map[string]interface{}
 
@@ -294,6 +367,9 @@ type EventBase struct {
 	Meta    *EventMetadata `json:"meta,omitempty"`
 }
 
+// EventIngestionStatus RECEIVED events were accepted but not processed yet, INDEXED events were processed and FAILED events couldn't be processed.
+type EventIngestionStatus string
+
 // EventMetadata defines model for EventMetadata.
 type EventMetadata struct {
 	Finalized   *bool      `json:"finalized,omitempty"`
@@ -352,6 +428,50 @@ type GatewayTransactionTask struct {
 	ExecuteData []byte `json:"executeData"`
 }
 
+// GetChainResult defines model for GetChainResult.
+type GetChainResult struct {
+	Chain ChainInfo `json:"chain"`
+}
+
+// GetChainsResult defines model for GetChainsResult.
+type GetChainsResult struct {
+	Chains []ChainInfo `json:"chains"`
+}
+
+// GetEventResult defines model for GetEventResult.
+type GetEventResult struct {
+	CrossChainID *CrossChainID `json:"crossChainID,omitempty"`
+
+	// Error Reason of the failure of FAILED events
+	Error      *string   `json:"error,omitempty"`
+	Event      Event     `json:"event"`
+	ReceivedAt time.Time `json:"receivedAt"`
+
+	// Status RECEIVED events were accepted but not processed yet, INDEXED events were processed and FAILED events couldn't be processed.
+	Status EventIngestionStatus `json:"status"`
+}
+
+// GetMessageStatusResult defines model for GetMessageStatusResult.
+type GetMessageStatusResult struct {
+	ApprovedAt *time.Time `json:"approvedAt,omitempty"`
+	CalledAt   *time.Time `json:"calledAt,omitempty"`
+
+	// Costs Costs recorded by events of the message and its tasks
+	Costs        Fees         `json:"costs"`
+	CrossChainID CrossChainID `json:"crossChainID"`
+	ExecutedAt   *time.Time   `json:"executedAt,omitempty"`
+
+	// Stage Furthest stage the message reached. CALLED messages were sent on the source chain, VERIFIED messages were verified
+	// and routed to the destination chain, APPROVED messages were approved on the destination chain and EXECUTED messages
+	// were executed successfully. FAILED messages couldn't be routed or executed.
+	Stage MessageStage `json:"stage"`
+
+	// TaskIDs Tasks created for the message, in the order they were created
+	TaskIDs    []TaskItemID `json:"taskIDs"`
+	UpdatedAt  time.Time    `json:"updatedAt"`
+	VerifiedAt *time.Time   `json:"verifiedAt,omitempty"`
+}
+
 // GetTaskResult defines model for GetTaskResult.
 type GetTaskResult struct {
 	Task TaskItem `json:"task"`
@@ -489,6 +609,11 @@ type MessageExecutedEventV2 struct {
 // MessageExecutionStatus defines model for MessageExecutionStatus.
 type MessageExecutionStatus string
 
+// MessageStage Furthest stage the message reached. CALLED messages were sent on the source chain, VERIFIED messages were verified
+// and routed to the destination chain, APPROVED messages were approved on the destination chain and EXECUTED messages
+// were executed successfully. FAILED messages couldn't be routed or executed.
+type MessageStage string
+
 // PublishEventAcceptedResult defines model for PublishEventAcceptedResult.
 type PublishEventAcceptedResult struct {
 	Index  int                `json:"index"`
@@ -595,6 +720,9 @@ type Task struct {
 	union json.RawMessage
 }
 
+// TaskAckStatus defines model for TaskAckStatus.
+type TaskAckStatus string
+
 // TaskItem defines model for TaskItem.
 type TaskItem struct {
 	Chain     string        `json:"chain"`
@@ -681,21 +809,61 @@ type After = uuid.UUID
 // Chain defines model for chain.
 type Chain = string
 
+// EventID defines model for eventID.
+type EventID = string
+
+// LastEventID defines model for lastEventID.
+type LastEventID = uuid.UUID
+
 // Limit defines model for limit.
 type Limit = int
 
-// WasmContractAddress defines model for wasmContractAddress.
-type WasmContractAddress = string
+// MessageID defines model for messageID.
+type MessageID = string
+
+// SourceChain defines model for sourceChain.
+type SourceChain = string
+
+// TaskTypes defines model for taskTypes.
+type TaskTypes = []TaskType
+
+// Wait defines model for wait.
+type Wait = int
+
+// WasmContractAddress Address of a CosmWasm contract on Axelar
+type WasmContractAddress = ContractAddress
 
 // GetTasksParams defines parameters for GetTasks.
 type GetTasksParams struct {
 	After *After `form:"after,omitempty" json:"after,omitempty"`
 	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Wait Seconds to hold the request if no tasks follow `after`. The server responds as soon as a task is available,
+	// or with no tasks once the wait is over. Clients must allow for the wait in their request timeouts.
+	Wait *Wait `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// Type Only return tasks of the given types. The parameter can be repeated to return tasks of several types.
+	// `after` may be the ID of any task of the chain, so every consumer of a subset of types can keep its own cursor.
+	Type *TaskTypes `form:"type,omitempty" json:"type,omitempty"`
+}
+
+// StreamTasksParams defines parameters for StreamTasks.
+type StreamTasksParams struct {
+	After *After `form:"after,omitempty" json:"after,omitempty"`
+
+	// LastEventID ID of the last task received from the stream
+	LastEventID *LastEventID `json:"Last-Event-ID,omitempty"`
 }
 
 // PublishEventsJSONRequestBody defines body for PublishEvents for application/json ContentType.
 type PublishEventsJSONRequestBody = PublishEventsRequest
 
+// AckTaskJSONRequestBody defines body for AckTask for application/json ContentType.
+type AckTaskJSONRequestBody = AckTaskRequest
+
+// BatchGetTasksJSONRequestBody defines body for BatchGetTasks for application/json ContentType.
+type BatchGetTasksJSONRequestBody = BatchGetTasksRequest
+
 // BroadcastMsgExecuteContractJSONRequestBody defines body for BroadcastMsgExecuteContract for application/json ContentType.
 type BroadcastMsgExecuteContractJSONRequestBody = WasmRequest
 
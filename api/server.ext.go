@@ -0,0 +1,61 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
+)
+
+// Server is a ServerInterface that also serves BatchGetTasks. The operation is left out of the generated ServerInterface,
+// since gin can't route its custom method suffix, and is registered by RegisterServer instead.
+type Server interface {
+	ServerInterface
+
+	// Retrieve multiple transactions to be executed on-chain by id
+	// (POST /chains/{chain}/tasks:batchGet)
+	BatchGetTasks(c *gin.Context, chain Chain)
+}
+
+// RegisterServer registers the handlers of all operations of the schema
+func RegisterServer(router gin.IRouter, si Server) {
+	RegisterServerWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterServerWithOptions registers the handlers of ServerInterface with RegisterHandlersWithOptions, and BatchGetTasks.
+// gin has no literal colons in routes, so POST /chains/{chain}/tasks:batchGet is routed as tasks followed by the wildcard batchGet,
+// and the handler answers paths with any other suffix, e.g. /chains/{chain}/tasksX, with 404.
+func RegisterServerWithOptions(router gin.IRouter, si Server, options GinServerOptions) {
+	RegisterHandlersWithOptions(router, si, options)
+
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	router.POST(options.BaseURL+"/chains/:chain/tasks:batchGet", func(c *gin.Context) {
+		if c.Param("batchGet") != ":batchGet" {
+			c.JSON(http.StatusNotFound, ErrorResponse{Error: fmt.Sprintf("unknown path %s", c.Request.URL.Path)})
+			return
+		}
+
+		var chain Chain
+		err := runtime.BindStyledParameterWithOptions("simple", "chain", c.Param("chain"), &chain, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+		if err != nil {
+			errorHandler(c, fmt.Errorf("Invalid format for parameter chain: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		for _, middleware := range options.Middlewares {
+			middleware(c)
+			if c.IsAborted() {
+				return
+			}
+		}
+
+		si.BatchGetTasks(c, chain)
+	})
+}
@@ -13,15 +13,30 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List chains integrated with Amplifier
+	// (GET /chains)
+	GetChains(c *gin.Context)
+	// Retrieve contracts and the address format of a chain
+	// (GET /chains/{chain})
+	GetChain(c *gin.Context, chain Chain)
 	// Publish on-chain events
 	// (POST /chains/{chain}/events)
 	PublishEvents(c *gin.Context, chain Chain)
+	// Retrieve a published event and its ingestion status
+	// (GET /chains/{chain}/events/{eventID})
+	GetEvent(c *gin.Context, chain Chain, eventID EventID)
 	// Poll transaction to be executed on chain
 	// (GET /chains/{chain}/tasks)
 	GetTasks(c *gin.Context, chain Chain, params GetTasksParams)
+	// Stream transactions to be executed on chain as server-sent events
+	// (GET /chains/{chain}/tasks/stream)
+	StreamTasks(c *gin.Context, chain Chain, params StreamTasksParams)
 	// Retrieve a transaction to be executed on-chain by id
 	// (GET /chains/{chain}/tasks/{taskItemID})
 	GetTask(c *gin.Context, chain Chain, taskItemID TaskItemID)
+	// Acknowledge the progress of a task
+	// (POST /chains/{chain}/tasks/{taskItemID}/ack)
+	AckTask(c *gin.Context, chain Chain, taskItemID TaskItemID)
 	// Broadcast arbitrary MsgExecuteContract transaction
 	// (POST /contracts/{wasmContractAddress}/broadcasts)
 	BroadcastMsgExecuteContract(c *gin.Context, wasmContractAddress WasmContractAddress)
@@ -34,6 +49,9 @@ type ServerInterface interface {
 	// Health check
 	// (GET /health)
 	HealthCheck(c *gin.Context)
+	// Retrieve the status of a message derived from its events and tasks
+	// (GET /messages/{sourceChain}/{messageID})
+	GetMessageStatus(c *gin.Context, sourceChain SourceChain, messageID MessageID)
 	// Temporarily store a large payload against its hash to bypass size restrictions on some chains.
 	// (POST /payloads)
 	StorePayload(c *gin.Context)
@@ -51,6 +69,43 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// GetChains operation middleware
+func (siw *ServerInterfaceWrapper) GetChains(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetChains(c)
+}
+
+// GetChain operation middleware
+func (siw *ServerInterfaceWrapper) GetChain(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "chain" -------------
+	var chain Chain
+
+	err = runtime.BindStyledParameterWithOptions("simple", "chain", c.Param("chain"), &chain, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter chain: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetChain(c, chain)
+}
+
 // PublishEvents operation middleware
 func (siw *ServerInterfaceWrapper) PublishEvents(c *gin.Context) {
 
@@ -75,6 +130,39 @@ func (siw *ServerInterfaceWrapper) PublishEvents(c *gin.Context) {
 	siw.Handler.PublishEvents(c, chain)
 }
 
+// GetEvent operation middleware
+func (siw *ServerInterfaceWrapper) GetEvent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "chain" -------------
+	var chain Chain
+
+	err = runtime.BindStyledParameterWithOptions("simple", "chain", c.Param("chain"), &chain, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter chain: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "eventID" -------------
+	var eventID EventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventID", c.Param("eventID"), &eventID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter eventID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEvent(c, chain, eventID)
+}
+
 // GetTasks operation middleware
 func (siw *ServerInterfaceWrapper) GetTasks(c *gin.Context) {
 
@@ -108,6 +196,22 @@ func (siw *ServerInterfaceWrapper) GetTasks(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", c.Request.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter wait: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -118,6 +222,62 @@ func (siw *ServerInterfaceWrapper) GetTasks(c *gin.Context) {
 	siw.Handler.GetTasks(c, chain, params)
 }
 
+// StreamTasks operation middleware
+func (siw *ServerInterfaceWrapper) StreamTasks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "chain" -------------
+	var chain Chain
+
+	err = runtime.BindStyledParameterWithOptions("simple", "chain", c.Param("chain"), &chain, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter chain: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamTasksParams
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", c.Request.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter after: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StreamTasks(c, chain, params)
+}
+
 // GetTask operation middleware
 func (siw *ServerInterfaceWrapper) GetTask(c *gin.Context) {
 
@@ -151,6 +311,39 @@ func (siw *ServerInterfaceWrapper) GetTask(c *gin.Context) {
 	siw.Handler.GetTask(c, chain, taskItemID)
 }
 
+// AckTask operation middleware
+func (siw *ServerInterfaceWrapper) AckTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "chain" -------------
+	var chain Chain
+
+	err = runtime.BindStyledParameterWithOptions("simple", "chain", c.Param("chain"), &chain, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter chain: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "taskItemID" -------------
+	var taskItemID TaskItemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskItemID", c.Param("taskItemID"), &taskItemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter taskItemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AckTask(c, chain, taskItemID)
+}
+
 // BroadcastMsgExecuteContract operation middleware
 func (siw *ServerInterfaceWrapper) BroadcastMsgExecuteContract(c *gin.Context) {
 
@@ -245,6 +438,39 @@ func (siw *ServerInterfaceWrapper) HealthCheck(c *gin.Context) {
 	siw.Handler.HealthCheck(c)
 }
 
+// GetMessageStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetMessageStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sourceChain" -------------
+	var sourceChain SourceChain
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceChain", c.Param("sourceChain"), &sourceChain, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sourceChain: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "messageID" -------------
+	var messageID MessageID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "messageID", c.Param("messageID"), &messageID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter messageID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMessageStatus(c, sourceChain, messageID)
+}
+
 // StorePayload operation middleware
 func (siw *ServerInterfaceWrapper) StorePayload(c *gin.Context) {
 
@@ -309,13 +535,19 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/chains", wrapper.GetChains)
+	router.GET(options.BaseURL+"/chains/:chain", wrapper.GetChain)
 	router.POST(options.BaseURL+"/chains/:chain/events", wrapper.PublishEvents)
+	router.GET(options.BaseURL+"/chains/:chain/events/:eventID", wrapper.GetEvent)
 	router.GET(options.BaseURL+"/chains/:chain/tasks", wrapper.GetTasks)
+	router.GET(options.BaseURL+"/chains/:chain/tasks/stream", wrapper.StreamTasks)
 	router.GET(options.BaseURL+"/chains/:chain/tasks/:taskItemID", wrapper.GetTask)
+	router.POST(options.BaseURL+"/chains/:chain/tasks/:taskItemID/ack", wrapper.AckTask)
 	router.POST(options.BaseURL+"/contracts/:wasmContractAddress/broadcasts", wrapper.BroadcastMsgExecuteContract)
 	router.GET(options.BaseURL+"/contracts/:wasmContractAddress/broadcasts/:broadcastID", wrapper.GetMsgExecuteContractBroadcastStatus)
 	router.POST(options.BaseURL+"/contracts/:wasmContractAddress/queries", wrapper.QueryContractState)
 	router.GET(options.BaseURL+"/health", wrapper.HealthCheck)
+	router.GET(options.BaseURL+"/messages/:sourceChain/:messageID", wrapper.GetMessageStatus)
 	router.POST(options.BaseURL+"/payloads", wrapper.StorePayload)
 	router.GET(options.BaseURL+"/payloads/:hash", wrapper.GetPayload)
 }
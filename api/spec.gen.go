@@ -18,90 +18,124 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a1PjOJfwX3H5map35q1A0g19Gb5shWCYVEPC45iemephM8JWEje+taQAGTb/fUsX",
-	"27It20oIDbvbn7qJpKOjo3PTOUfyo+nGYRJHMCLYPHo0E4BACAlE7C8wIxDR/8AHECYBNI9MDwLvBsLZ",
-	"Hv3PXvF/aZvZMf3IPDK/LSFamR0zAiEdyqF1TOwuYAgoWLJKaAMmyI/mZsd82JvHe+LH5dL39q+uhify",
-	"73t+mMSI0LECKO1mdswEkIV5ZM59slje7Ltx2J3H8TyAXda+Xq875g2KgecCTIYn2y+JzZOtSAbZMRH8",
-	"tvQR9MwjgpZQXudPCM7MI/Nf3ZzYXd6Ku8cSDIqmuwB0JhlBSBYQwWWoRoIPaJq+SGY6SeCHPilM8qan",
-	"3jTeU4bmwRlYBsQ8etvrmKEf+eEyNI/edNJp/IjAOURsHgLw7ZDAcHcklyBuS3EnB0FxvAc4HMQRQcAl",
-	"fc9DEOMisuABBgC9eR/C24/Yc/G3Dx+/BmS2hAfv/lmE7zzccx+SoOfNIgSDj7fu7cHXewJdj0TfXPjV",
-	"A3fqlajmbVpSAgiBiML5T4HRF+B6cDZffL0Nwij5hjBZ3t0/rP7pvT04fPf+w8dfrx/ffVz/ZHYqHLBO",
-	"AbOlSqsO/egcRnMqTG8qwzpmP0msOxiRC0iABwjDCwTBeGYefWkmenHYuvNoJihOICI+5OQOfUKgd7yS",
-	"kNGDnA5YX3fMaBkE4IbuGqfew14c+gSGCVnxn9br9TVfxzAiEDHZcRCI8AwiG7rQv4MeQ3XDlR0DDBWr",
-	"CuEmNKpQV3dJinkxBnPIxa5lTxF0/cQXS9YidMfEMPK4ZZjFKASE6sIVgaYCPI6XyIXSrupOwcYNUm3Y",
-	"sggS38Io3cC2Wapb79Dhv/tkkSGwlkXxi0TQImbl9WWkkelaRu+a7qtYQnzzFbrErOPJCYzI/wJ+9CAm",
-	"fgSIH0e6OyoPqWpnTSbaWg7KbN0yNpcHTcQYQ0wSDal7IrNWKN9I2Sb25diqeffYnw/5UiQr9XPvv/b+",
-	"48ubvV+v//rL+/+//KTSDsdFp6yJzM/pHkqI2BAncYQhW0yBiUsO5CZOnbw7MhglLdP2CQFkyY1jRF2s",
-	"L6ZtDazhZ4uueXI1GFiTidkxLdse2xIkiVx03N4dQJQYmAIowc40UmXWydJ1OUOUGiyEYmReV/Gspxul",
-	"TwAJ9PpF0fIAgXvED5VmA7J5KixR1j7VNZe1EeNktspNpscZ7bW2WWwVFewHpjLZUIpIK4zfAQ65hl9n",
-	"eACEwKpmNeThN4AXu6BMiS8lMmXrV/HnAATBi5mkbPIXsUlCsbZt6YXotqaKZxXEwNPylO59smC6vQ3+",
-	"VYT9eQS9zBDYYhsV2iZFWWkJUuzUSr1K6515+wlArEOtdd5GzjnQSZPfuA1YbhEVPshzHE8y7zKOCHwg",
-	"mpyW9hanmwGIophYD9BdEii6vKDE1iCzCwkmwA+wlm8HcNwqVypUbT6yEsvYIMAgi2MpfMFgd7KFMDn0",
-	"YIKgC0geA1BIZhtNGXXKcIrUm6E4fHZupvYVExAmuqZ3vclyP799VRz9+e335OnNzjZP5/9NDuTap+fd",
-	"i4C0Ca9GCLbSHM8oPHbGDlX6pGeN4WhydXo6HAytkTM962960qifdhjh5Wzmu/RseQboEaO+b37aKPSh",
-	"ZNqhQXNj3GppB7TPuvO8Noeu6/sbnGaWkXCSTqKDq4kzvsh4oqNiF+ePqW19tmzHOtmGafKJB0tM4tCs",
-	"RYxzSW1zC8PlHZ0HG95BRFiILu1lx8/iRL28npcXljJdg97W1dQexC7yE3rMMI9MRgJDBPeN+wWMDGAI",
-	"sIbL8DBuoIEoLt6+wZ13g4W7jHhmkAXMevvY8MMk8KFnkJgOoo28J9Xixv3CdxfsR8gm9XE67/5fkVnL",
-	"5goyNOrFEudvwtjVqUqcXe0gKcA4wgQtXXKJ4nhGmbYaadnp+XQLA184UjKU2Znp30uIVnJ8CHieTxkE",
-	"BJcy/krzJXQziFYaElc4GTOpa+p9CiE14nQWFGPMfBIuZttnU57gJRU9o3xSlYo+KR3mKT8U/R5ZCtMW",
-	"AxAC3AWXoLO+Y/3e/3Pq/GGAyDNsqz9wps54av1xObStk+lkeDYajs6mE2syGY5HBrUkeN/4K3IW0BAE",
-	"Whl/YzdOoCdYCv9NhZoKZyxE3udCTAcbPo7+HzEAxrHrA6YPfLIwQLTKZPxnuD/f52oCMu3sR3PjbxQT",
-	"QOCE7izCfxsLQhJ81O1K0VyeEI0guY/Rrfhrbx4me9i73cNx4Hs+WXVvgvime3jw9vDwV+/DzcFBD7rv",
-	"oTd7Bz7C3ofD9+8PD8HbdweHH9+74OOHriu4F3fngMB7sOr2Gdw+VUIzH6Iz/vM+joN/nb/pHQzeHO6x",
-	"f99++IWrnCIbFSmlHSAs8GY5RljkuMn3DFWopJWpq/pIcE1QV2HGvi2hTk6gLEJ8ApXAZKbb86lkhFR4",
-	"BC4gSSiso0ezf3nZHY4cyx781h+Opo7dH01OLXuaxd3rE2Vt6eROLfCJNXI2ApznBDvmoH9+3horZf1G",
-	"o7Eztf6wBleONb2wJpP+mbXxCbcWUpcexTc+LlfBOf3JJ21XuYSSPdZeWtWp65hn/cl0YFsnw9rdOAN4",
-	"gKDnE3mIbZ1ejU7qeeMMYBvOlpGXc8LQmRQ4YfzJGk1PrMvz8Z8X1H2eOH3mNNdlBJ2JxBTU0p3AJIhX",
-	"IYzIhADqv9bNJHhOD7JgNxnW+XD0SeDbjuS5H90y9BRIcRgXltM/6Tv9qW2dDSeOZTfCY7BSU2bDuY8J",
-	"RDlYsfHT/uWlPW4QVrHt/SRB8V11uODEtuGCDWuHNwiECgKTBWpxLXsytcdOvwEDYQhtZhW9LHeUmuSR",
-	"KJSiGnDdMeMIaqj+Emu3eU4Vpm4bkOuitp7K7dEcVNyUbQZ9fquxlDrluPVI7VmremujOXOl2TZMzWMt",
-	"lQp1Iq8xsFG2NcbrKcTNABX0X9vQFku91fCiB0G9sqI7xZ0cjRiEw3RBOTJEf7xOXSMWpqg6bLRJ69yz",
-	"WQh9qwRQydUTqNU6e/JxqBT09SMQ+P/wQrGsnJXPW5MhvInjAIKoJkX4OlMpHZM87Ca3uq6jsSM4MA2O",
-	"SD5UyTsSrqrCTCtMr9qc1nqxTU5pjXeodjyrJrjW7WlzY/S9vHovreXM0Gk/r+hFp7KtzLwAs1P4MbX1",
-	"8s/Uost/l+y2oik1tA1NbMOkKar2sq1dBUG2mrXjWURNaixaQLlFYedKzTXWrNSrzWbVdxf2Qe5Qa39a",
-	"O0llqR1TJkZFb4I74DPNcQbwMQhA5LaanzQQ95pCkx3lQujqT6HCBhZCaY9VJet7mpWLoiSRR3FSe9kS",
-	"n5RqaHgV5ka1QRVisGJIDkdlN+UJK3R4TmPCIrG6ATG6S4o4WOkIs6PCAP3IbwKY8G64QXR/qHLdtLa5",
-	"MVMjA8wRUyf7Kke5755S3apIe/NacCRW2Q/j5cbb1EjuEkqVqTqcHHX0Z1Fkpo2BS7WMWvnyeDg8EbL5",
-	"FJUog6Jq7wwSngTFzBGuCL7ARycDrcw/K3WNmBQ3zaqvE/L5y4pBgY+6rlX7FLmj/O+21adacqJlKyrL",
-	"nfkRy8tVqKaoHZVFQFiUdTtZC2fq3RASEJBWRLeW2ErrkBTIhpc9nnnrtr0wtZMrJfUVxHW3SaRpq5eh",
-	"FPTu5DtWyzDqMNJLyZ00hCG1PedszgZPnJBtjr7CuAARmEPkaMSVnHL/JrZJ0VAykGKh9TRXIFrLRY0x",
-	"xZ0wE9hYSD3o+iEIinu59CPy0ZQuU/eql6k3YZ6GnQAShQUmNfSrtQ2KA9LWa9ru7MT/aJegVXgTB5tS",
-	"ix2R2AwZBJlYzaSqu6FXPUpv5ISK63WpmD7xrECKAiVQ2XBlqjqZJy1qc77OtUrDEj5B1wW3b9+9T30E",
-	"6YJi7+FLb+9XsDe7fnx/uFbeT7zIwxa1duR5r6WKqEWdi/NMzsSzFHtreQfyelUbqswPvsAtABUeTy//",
-	"3+LcrB1Sqw2M5adTjcL7xnXv7IKYG4chiLwdRZxYjV/prlR9GaIZgodsvl6vp3LkwIM85E2vnlDFnPTL",
-	"MWoBjxdh1J3XT+pe0y1QwI+j9LauvuLiA7aTFDXhdycpCz/wspuUxYhJC/VqJKnp9vFO5ZKS/w4ikpdd",
-	"P1G2y6Uc/yelzS3VMuvXlpbkoQCoMYZZI2CNdfTiAYPTq3OzY254T0Q9n3izYLakHru6S+F+x+XyJvDx",
-	"gm1R33VhQqCXRyP1tluGwccOCQxTTlqXp0nrY3c4x9YltgT5nA8fy0UO6qpaecx1ZWU5Yq1FtoOBddlQ",
-	"3tawLemlC42RMqWrxXE4e69BrzyuAae2yp5apOopqK7F8SMPPoidbThS65lEeeIac5gZPT5xGd3q6yTZ",
-	"xm52N6YKNKWwqZoxvxEjt2Gbl4zXlDDpJxKycsoQPAz5gDc9/tBd+mdLhkFMqNKTJYzViQ/EftfHuEYK",
-	"2xIh6TQqPP+9jNEytCG7K5I5rmWTFBHRIL/L8xXH0b4N7qVEvh5HfobIn/kuaHDQJDeMz63CnWJNnNh6",
-	"SOiwiT+P/Gg+gRjXZta2fdeHSsZdfAu97d+nEhcdLvNSh7anYlI+Zw9TsEVxrAuBt/eHpvI5xgIxs9Gd",
-	"0juWNauqYHudU9tOLcNlHASvjspJHAS6VOqY3yrMry+LCsFRVCo8YddLmyhWtu0Oqhcr7Wv2OFFNTvpO",
-	"I9tUeOBoAf35ovgOU7oTTWattGwBpSMQ4AjPlpG3q2uRPIFvP6XYIAQ+1Tz6pVJaFQdmLW7qOSlpVLXc",
-	"L3AyUqDxPR+6rD3sq480Tdju7tHTJHYXGwtDx8Qcua1iwvzALL2StMXVUV7Vy66Nfrbs4emf6eXQITFc",
-	"gJAPsXEHgiXERgIwhp7hR+waqHT3c9A/Pxc3tX/2ZwaIVr8YN8C9pfBpVyxdBVfepnzSO0mqHScxgkIz",
-	"1vlmt2k2oW3CYtqhzHw5GBXrpUpM72iiuBfediSRizzbb/ooy5PahintR/ugTIdrwq/38jQBVB2XtoHM",
-	"T13xrtdit9JjbzkypxfE5ElQPY0iv/RxvW1daUHixUMjOmPMLW8h6JQTAHyrLCNg6dj0ifN8cgFV4C7v",
-	"w0s/JFpWp3r72nifv/WyVo0yz/izfE1jMB5NHPtq4Ewv7fH4lB7Y+TUIdmsjfRaAxeXEowC/9ycXU+sz",
-	"v3bA1b/ZMbnyl/vVPB4gd7Etxx72j8+t6eX4/FwzSJCuoqjtzHx5QqdJv1Q1l9RY1k+FJqqFpB+4xFcH",
-	"K5VPtVtBxXBWTYvztkmfa6TNn/wUaEM+3VHUCaVsNeo7w8/WtHzpxeyYF8ORMz2+skfTU5s9nnI+Hnya",
-	"Xo3oP8W/pqeWJQ/Q5Q8JrREg/h0sFbCYRdQv/IgcL1F0itjzK3LTeezeXkVB7N7WNpxCWAOP7W+pwqHy",
-	"KnLLm8jFg8DzV1k8J7s0PVX6P6J+RBGWkjieJTGsE+tkOh5NJ+Mre2BNGeebHfO0PzxXNozGzvR0fDVq",
-	"GOSMp5luHY6olj6z+WvPV6NPo/HvumJRxZ4lSaAHvXE0KeQ4q11PgR/o9BvF5DReRtoQnTjTqNUuw+gS",
-	"xXNxoq02X0W3UXzPxUzyxI5e2+O+291Qanyit2Pm1qoqOoQg/2ZJ4BYPT/fTsapYVeq8bSRY3DWTcFJJ",
-	"lmJ+xXlrpbFz7Ki5KZIUdDq0Dj0pqaD3/pQ0iCq7MYN2HHurVh+uNHLCkOYjr4vYlABv9pxW/TSt5GOR",
-	"2FlcDRFQSztHjGuNAKwgMmYxMrJnkvgRnpXu+oR9UCdvOru4NPqXQ7oREGEO7s1+b7/HUnMJjEDim0fm",
-	"wX5v/0B434wvuhxm95H9u+7mSZ5EZMopHRhKQ888KiZeGKD8M1c1W5p34ZOx85aIn6YEk3IgIEkCoam6",
-	"X0U1g97niJRprHWRXbNyCf6yElvp217vuXDgCcr1uvya3/gT3ZfDHU5cfDBKMeUx8Awp53HYO/x+czMd",
-	"bIxiYjD7Rud/9z3XPoHoDiKDZzzZN5uWYQjQKmdoI472+BOIQgJor7JwZDep5lAhGuk9rG2lotPakX/3",
-	"TaMj/9YYl7NnYvTSrbMGHv/BZ4zP4iAwSH6EFk9vihuEnhFHhuCDOsbrPuZP0K7buPD5mJAUA2fPzWA/",
-	"+EuPv1hwBN5BAzRzmdByNytDhNmk5xkfFV/SW3ezjGiDW5Almi/wXASQUjgbc6Lqc37P5TIUU8Lf1VOo",
-	"fifpVfoJr4W/M3IZAN34BAG0MqrMJvP+ptzdfZRy/40Ktjpv+ftFO+H5dl0sFys8qzKu+0TV61DLOW+8",
-	"StV8BomR7ZSB85KsVub8toQo/dCPUu+yB6HTYXRv4A91W5vdVTyh/eNolmnOVyk6bLckjZ8KDJMinuPs",
-	"LiAIyKJWX//GmgcLyNIQKv6q7n8BBw7AcBkENqMIJTbIpVwCYerKUuwSSPYwQRCERfLmQVE/Auyj0oqY",
-	"0veTJUWBxw/fpYGLHRgmMQLID1YGprQzgBEANIeG4CQDzOl5jxg+wcYC4AXz21cJwNjA/j/QQJDuNHNr",
-	"MD0q4jgUFT14v8iT3Uc6vtF9yfmyZCkU39amwLb+Pni5dmczD+WJ4vDiXokg86s/LjKO9DJWpCdDwYUU",
-	"5vq/AwAA//8n6VWJ2YAAAA==",
+	"H4sIAAAAAAAC/+x96XLbOLbwq6A4UzXdX9FLEied9p+vZEv26MaRPZKc7q6OrwyRkMQ2SbAB0LbaV+9+",
+	"CwtJkAQ32Y49d/InsUgsB2fDwVnAB8vBQYRDFDJqHT5YESQwQAwR8QsuGCL8D3QPg8hH1qHlIujOEVrs",
+	"8D928n8l7yzb8kLr0PozRmRt2VYIA95VjmZb1FmhAPJh2TriLygjXri0bOt+Z4l31MM49tzdy8thX3++",
+	"4wURJoz3VYPyZpZtRZCtrENr6bFVPN91cLC3xHjpoz3xfrPZ2NacYOg6kLJhf/sliXnSFelD2hZBf8Ye",
+	"Qa51yEiM9HX+naCFdWj9bS9D9p58S/eOtDE4mM4KemEeQMRWiKA4MAMhO9RNn0cznwTdorCEB3Z/mMx0",
+	"uH8P587hu8Pj3tmZedpkiLqJAy88Q+GSk+aNbQDDh5QNMlBcRB3iRczDfLphH+AFYCsEeDPAIL0BBDnI",
+	"u0UuWBAciHeUEQQ5Zraj5wpBF5FsVWeQsh0B0o5Y2wvxqu8FHstR582+WahkSx1QFy1g7DPr8O2+zSng",
+	"BXGg498LGVoiIggQIErhEhU5QVB/552Z8Fmfx5Ce4pg46Lgrs+vdHjM956YhQ8HT6QJtxG1VwTQbIoFx",
+	"uo4QLcvGeeivAUEsJqEQDJqIytK7RSHg66W7YLpCIFXowIEhmCNAUIQgQy5guDQCRbeIQF/1/xpeC5V9",
+	"DQK45l35BFIqYbiWAqmmFTrIBhQDPsIaODikcYCIaAtoPKeIibZ8YAHJDUIR8BgF+C4ETkwoJrtfQ12O",
+	"f7cGvw6OL6cDy7bGg5PLUd+64q8jH7soQaxJJAS1dZx7DAW0DfI5tq1Nyi+QELjmvylbC+5YYBLw33fQ",
+	"Y2WiTJCDQ5dyxK6w7wrEcEZAlAFvAUKsEL3Avo/vgEKuJBNF5BYRQBCNxBiQAopxyP+HEtMeBfAWej6c",
+	"+8j+GmIC7jy2ykbFoSMpxIHjrfEtIrvg2Pf4MkEQUwagmHiBidYw5H97JIWUeQHCMaMFaryr0D8CFUb1",
+	"w7UPvJfa54OuivaNqugO0uAYh4xAh/VclyBK86IJ75EPyZsPAbr5SF2H/vnTxz98tojRu/d/rYL3Lt13",
+	"7iN/312EBPkfb5ybd3/cMeS4LPzTQX+48NYst6Z5txXg4jhCl6uXvG/PueFsNpao5k8igiNEmJcIOYOe",
+	"L/4M4H2qwPb390s6jDMlZHErtu45NxPZeLPRl/Z7MsZVOjye/4EcxofXaFCrTNOWJ5gE0CAWJzDw/HWi",
+	"KaBszJkwgKygPyDlmmPhLWOCXM6aXEHcYuaFS3CLiLfwENcSciaaqCXousgV7C6Y1waO4nm6wrHv8iYR",
+	"QREkUumtYOj6CNxCP0aUz74GLg7/wcBNiO8U14ecT3+3BsOL9+8t25pcDvm/08HZWW8s/uqNP40GU8u2",
+	"jnqTwfuP1lURL9wQ4MPs3ELCOY3y8XKYSgbPPZzEXukRQ74PSfkxJDchYsXnR5AiDhAnTBQJU+YzYtCF",
+	"TLAv9P3zhXX4ez3X5Ltt7CKjosBjDLlHa41L2o2cisaVbYWxL9RZImT3Ozjgujpia/los9modQxDhojg",
+	"kimBIV0gMlamoAC148o4jgyrClAXHJWw23ZJhnk1K6xB2AhyvMhTS26FaNuiKHTlOW6hZNSarxmyDMNL",
+	"+0qjatsp8uZcwyIYvkFhQsCmWcqkn/Luv3hslenZvFrTTdS8xZhfX4oaHa9F8K42RvVo4skJCtn/AX50",
+	"EWVeCLn+bktRvUt5E2/JRFvLQZGtG/pm8tASMMEQk6iF1D2SWUuYr8VsHftKaM28ewSZszpFjNsGtNIY",
+	"8VzayXhOTi7C7BvKXm/2peGX/Cya1gVU8ClbAExj3wBv4FHKCbwdzEWTXxjVnceyNg0rlMPaKbDG1XrL",
+	"oeS0CDKGSGgdWv/9w/7/7Pz/39/s/Hz19av7/378u0l5H+U9XHVS8Jz+Cw2QsTjPUFSmVsEb18VDpuNT",
+	"H8aIy+T9JLWWE+NuPDgeDL8M+sK+Oz4eTCaWbQ3G4/NxS2uuMHa6YZRmncSOI+W18GJACCbCVCuNVYU3",
+	"jh8fMeT28prPhQztMC8w7upIzFNiieLmUF5zcbMQikasssv07U4qRVJxUboXO1p7OfwF0kB0KQmieTXs",
+	"/p+Qrp4CMwW+1NBk152yjqHvv5jFkE7+IiaD2veaSPpZNdtwxbP2MXRbGbLcOSK23qbxL0PqLUPkpvv0",
+	"WJHRoG0SkI0bdQKdec8t4/rJDmP8ZMsbVBpP28i5HHRSZ9ZvM6w0WAwm4nOcHlPjH4cM3bOWnJa0VofP",
+	"YxiGmA3ukRMzpJq8oMRWAPMUEpz5nppMb0hxo1yZQB3LniX/eweneNGY0lzuYmw7XYiQQxdFBDmQZZ48",
+	"g2Q24fTwwTBOHns8HvXs3Mz3V8pgELXdejddlvvl7avi6C9vvyVPdzt6Pp7/u/hLWjs3nl4ENCK8GiHY",
+	"SnM8o/CMU3Yo4yc5awxHk8uTk+HxcDCazk57XU8a1dMOQxovFp7joZCdQmrVgZidNnJtOJqecENzMGXN",
+	"cRLKrE3Gp88iczLS8q03nHqW0WDSTqLHl5Pp+eeUJ2wTu0x/nY0HXwbj6aC/DdNkEx/HlOHAqgRMcknl",
+	"6waGyxpO78foFhEmPKhJqzF+FiPq5fW8vrCE6Wr0dltNnY+kCRQAFXsBdysUAgjUsDyoHmImIvwcFncX",
+	"SONdxtaSQFvS2qPACyLfkyExFduXLUVqzd3Kc1biocjz4e3VvDJCVsHmBjTU6sUC53dh7PJUBc4uN9AU",
+	"IF/pMFzgsoMFFqOZLTYv1XhjW0vI0B1cdw4U21YQ+8yj3vKC8EjmFgNIJ10jV8tw6hcVTe08T4GpxaR2",
+	"AWkZGkrTldZpVJk4pIzEDrsgGC+4SimT6Um9B1uYX7kDv20V8VSKgqsXMiHmGNOAu6qAo7oBHIKeyG+w",
+	"bN3rq3IefoeOixbL1R83fhBGfxLK4tu7+/Vf+2/fHbz/8NPHn68e3n/cGH3CCWD/4mkbulsRuq7HYYP+",
+	"hY5Yo9WjtnQYrlso6pxDRSjrutYnCHHbj89CMKVSMPuV9G6luB9hXOcN6mxSE5v2Cz4gzqh5c1lngOQN",
+	"gIxBZyUV72lvOvil99ts+iuAoQvGg97xdDY9nw1+vRiOB/3ZZHg6Go5OZ5PBZDI8H8lUn13wNRSpXRJB",
+	"a3BNHRwhV/E6vU5SvbDaKTyp+1UaEU91gJRixxNpYCKLiOdzJVvDD2h3uSt3FyQ2dZ59cU0wgwxNOGUJ",
+	"vQYrxiJ6uLenBQEkr4aI3WFyo37tLINoh7o3OxT7nuux9d7cx/O9g3dvDw5+dn+av3u3j5wPyF28hx/R",
+	"/k8HHz4cHMC3798dfPzgwI8/7SXyQfeURtmTUtLjexdXKKfy8S7F/t/O3uy/O35zsCP+f/vTj3KnyrNR",
+	"HlOt/co53jQliGVsM/mWHi6TtIpdrjqAUBELMFg/Ii7YQuAKIiQnMAlMavG5HpeMgAuPggVGkYrd9S4u",
+	"9oaj6WB8/M/ecDSbjnujyclgPEvDNdXh76YkEbty8MlgNO00cBbpty2RI93kYhftRqPz6UxlNc4+DyaT",
+	"3umgs2OkcqS9L287D/blbXm4aW/yqfUJqwDS+Lz10spnAds67U1mx+NBf1hJjVNIjwlyPaZ3kemh1bxx",
+	"CukYLeLQzThhOJ3kOOH802A06w8uzs5/+8xPXTzFa1o94nA60ZiC73R9FPl4HXCnOYOEVc+keK7dyIrd",
+	"9LHOhqNPCt5mIM+88EaAZwBKjvF5MO31e9PebDw4HU6mg3HteGKsZCsbo6VHGSLZsIrws97Fxfi8RlgV",
+	"2XtRxK3AUnfFiU3dFRtWdq8RCNMIQhb4jjsYT2bj82mvBgK1EY7FruimIcdkSx5pmcgb28IhaqH6C6zd",
+	"ZDmVmLqpQ6aLmloaydOyU54o23T68rbFUqqU49Y9W89a1lud5syUZlM3M4/V96kU+RYda2W7Rf92CrHb",
+	"QDn919S1YafeqnvegthcpTKuzClp5LRwXcnagqJDkT+8SkyjI2g02LICqUbn1iPCym3tTR38BLRKY28Y",
+	"LhHlB6As/SZ/KkqsOulmouAOEQSg46CIn03mMQPcqRUR7CBKkQvWiNlgOOoPfi30yZrwg9RJb3iWNXB4",
+	"Gjg/9sy1dvlMby0ZSI1u2ZYcpaVXyrReLSXI9HoYuui+8u0J9HzlPC2FIwtxFy+EvvcXcnP1F5KGFUH6",
+	"OcY+gmFFlP51RjN5us7TpDdsqvh1qqQ5YQvNHi1YmsrsN5g8BjPGbJpUngjqDPwKS9tsxJfNmUoTsskk",
+	"bG8xV1u8Decvu/ns10EUOSlTi8qycw8Tu0l/zK0j/XfBBjK8SoyWmldf3uovTbZH03vTCLoFUtlfuE21",
+	"l3lrQn9jsBkKryssg0Krpv2/urnaa/UGlXt5YyMtcd+2dGSUXf1JPd0ppEfQh6HTuJUnTs3X5H+2jQvh",
+	"qz9ByFRkpm3AD2Ul67ktk4dVVvCwr9seDb5eLY1N5ql3Ss8zJIwn+e5GG0SfsISH59xMTlAH5yKnksGn",
+	"WDgOPlFuTnsvegSF8HYkEKcPV65dqz9qg6X6gBlg5nh76Vj8zbMatipj6V4tQ9QqewGOO5OpFt0FkEpT",
+	"2RIdVfgXHnmhjaHDtYxZ+crYAuor2XyMStSH4mrvFDHhiq+qFEmv2KilZhoiLk4nuxvXriamtTN3CDtk",
+	"MDTUlaiBK4ASnF0JUyHu1iUOkgYTCqc6EW5Pcg4W0PNjgvjP3LnMWKlw20LlpO625ytGMJ5ejcffLL8/",
+	"B08FJZQ9kFZ5GCkClc3ZZVkO9P2OPTBlHY53KlBbzEnhSo8CghxMeBX2fJ0cugsJJ/xUzqu5ZQEUn/4x",
+	"bKfM6450b22uTZiy2UTCV9/gtxCVaMAh8iqN5EYFtVhb3aoAOE6ILDEXTgrV3rKfokItjlzYEQeqhr5D",
+	"n6Ka0QmR4DRDU8JUOnAVgiATxcz8z9SG0a7izpCjVzcprZv12Sr+TAC1dpk+UY7cthU6rQyZVsZ8abkL",
+	"LxRJKCWsGeprdBtFmfybZrTmHMhPg0jIYFI11liGpK1Ds/A61is/M+m2rfl/kqro6iqrqoJobdpyPb8B",
+	"33ZGsUqGMcdMXkrutC4CqO05pzsbPHJCQZz2CuMzDOESkWmLIMq02L6ObRIwjAxkWGg1zg2AVnJRbQDt",
+	"SZgJdhZSFzleAP08LWMvZB+t+iuZujBPDSWghmEFSQX+KvcGgwdr6zVt59xqmVpL18Ec+12xJXxYKo9W",
+	"jaAjqx5VVZdMlM8UnbwE6gqCREwf6cxheYFSoHRcmSkp9FGL6s7XmVapWcIn5Djw5u37D4mNoKXz7t//",
+	"vr/zM9xZXD18ODDn637O/MqV+8jz3qyi3MpVJs4zGRPPUhDXyjrQ12siqDEZ5gUqJU1wPL5EcgvHZuuY",
+	"R2XkInMftihOrF33kxXROzgIYOg+UUhgc5UBrlWfV+Tct7jsL4D3epc3+9WIyidgvRyj5uB4EUZ98mKB",
+	"tt7DHAaq/Yc1iivxKG4jKWbEP52krDzfTW+byHtMGrBXIUl1N7Q8qVxy9N8iwrLStEfKdjFv8T9S2rb3",
+	"5NZ7F2uCTBUCVltrqC55Ork8ExcKd6qlNc+n7nVaxNxiNzfJ1cDmvMvle1JjwlaIMiC8qjnnOUGiYmgX",
+	"8JyjQT95rlLfKApFARnvQbWaTxt8GYyHJ8NSh8QN/DXkXnlZLsqrkfgAmomUjJJkNhVGSaIUydSlnsLn",
+	"nyQ8pZ2/hqJ34sIHNEWiv95NAkTpTHrqngIUk7RzPodPIseyrWTZMqMoycrSsrE6JfbpVDsWURYrT0pV",
+	"3Fh8rGUO6Y+1rCH9sZbtdxHPfY+uhCT3VCpk5rRupxX0MWRf7qxOFM6mOE1SM/SEc2xddsSIJ9XVQzFZ",
+	"0VxppPe5Kq0sA6yx8Oj4eHBRk/JfQ5akfrlFTx3T5YIBml591q5koAamjd2+aw6oagya85M9nsWqKFvj",
+	"eWlnOekT199ZbauJi+CWL/pLCdutzLw8aIJhyzRjVlyuv6u+XhN1u9kujXlvfbmmmtC0nRYgNsfHiHje",
+	"HuIKKWyKlyXTmOD8V4xJHIzlbpieb4qWS8jUC/2Kyz8oDnfH8E5LyGvHkVK7O7DGjtesdTm3CXYONZvi",
+	"wX3Eu/FsTC9cThCllRky216RySXjFt8gd/ubeFXx50WWsth062LC5xyvclHDfs59xP2zHw4s493/OWSm",
+	"ve3C93UqVlWC9irD9jjZGS6w7786LEfY99tiybb+LDF/e1k0CI4ho+ARVC8QUa1sWwqaF6vRNb3n00zT",
+	"VllEubtCV8hbrvJZEQklGr5boS9bjZKkMUmAeercU91hIRPxxo9JGgygxzVP+5TnVpmDViVs5jk5akz1",
+	"bS9wgDaA8S2v9K/0CZlPvnXQPt3nHSLsrDoLg21RCdxWoQPpV9EuHN3iOg1ZnSPOneIM+FtyYcaQAQcS",
+	"4iGafPcjgqJYTCVqafdh8FOkuvToB0986ehHMIfOTXJA1k/YxhsmHnXlqIniDBOkNGOVbXaTBJ2aJsxH",
+	"p4rMlw1jYr1EibU7mhgu8Wk6kujFGs3Vz8Y046Zuxv2juVOqw1uOX23ltRygbLhs7BZ26lo2vVLUyj78",
+	"ox2HLsbn3A82HJ1attU/Hw26OkRyI1/IekrZOPemj0NUfKZ5OdIEuur07AZXgee213h6KuXVtvUrOY2k",
+	"skPb9LG2rHZskxWTfrXMlFWQfBoym1yNqmDX6fDS3wwoqvt2dK29g6mxwL5is0nlp1gOenw+mkzHl8fT",
+	"2cX4/PwkcyqK6tDkKifhXlYXOf3Sm3yeDb7I8ka5PSUOyt/0dhUXPulNxoPpeNg7OhvMLs7PzjrIqigN",
+	"zGljK1ue0rnak7Jm1V4W9WfuFdeS2gOpkcqdjcqx3CynAiWrJjmm22SBtMj+ePSt/zVpIVNDulvCVqPe",
+	"dPhlMCsW13IP8XA0nR1djkezk7G4J/Hs/PjT7HLE/8v/mp0MBnqHtvyhgTWCzLtFhTwsKw/6Zy9kRzEJ",
+	"TwgOCq/OsHNzGfrYual8cYJQxXiCvoVEndIHUBo+f5I/qDx/stBzskvdVwn+LdKgDG6zw4d8LG7QH/Rn",
+	"56PZ5PxyfDyYCc5PbRHDC17efnJ+OarpND2fpbp1OOJa+nQsP+xyOfo0Ov+lrViUoRexPuQi9zyc5EL1",
+	"5abSyGluN8LsBMdh6xGnONWo5SbD8ILgpTpxl19fhvzrhlLMNEvx8LV9x2O7Sujar3HYVrZblUWHMeLN",
+	"Y4a2+MZML+lr/H6U0vGdBEuaZhpMJskyzG84D67bXAXLj8JdgeRDJ12rwNOCHu3uDNU6cWV3LkY7wu66",
+	"0YYr9JwIoGXPqzw0hYG7XYFaPU0j+oSneIHLLgy+0y6JDJj7cI2IqCNLr7YEqqbTtpjH+C5jZa9OP1+A",
+	"3sXQEiVdVA73Znd/d5/DiiMUwsizDq13u/u775T1LfhiLytAXSJBH75uAcLQtQ6zAlbhtpNXSIrWb/f3",
+	"C8EVGEW+UjF7f6hsmnZfyS1WyQoUFb5v/Ykv5P0TTpq/FdMw5UR+gVm0E0xP4yCAZG0dWmceZYoawFNE",
+	"S25OTWki+ij87j2I/zeNeBa0UR/oppVSkjWR44sj7LOTp4k6B/sH3446AiIwwgyILfM1cYc4p6BblF7m",
+	"TIUD0vyxYwgUBcvMspdFhCNMDUyTi9I+inOEHku015Mg0Bjz3uT3jjQF75kY1xTFruHeb8g9R9AFWoD0",
+	"u+RIyVEEAzjckQljSgKqhWPvQV05V6taE8/EVgJiNzZUIDy7FtZvbXhNWhgTIEB75eoYgkjyF3JVPCe5",
+	"g8BL7nUANEvoKDJcWg5exWWimPz5uAwuGCJtGvpe4LE2De9gu3ZM+eHos3O4Xo//3c5o0pbY9wHLvLLq",
+	"wy1pIm2SdlvNzXuUEQQDjanzk1/EdIXUFR0AUkAFMDsiv1jd6sGfYhzy/9kKrf/B84CTy8fSay8oDJK7",
+	"LyAFS0Xn3a/h4BaRNZhMBtlXZfg1IesIgWs+67Ut5PPac6/5u/SjBcN+KrvX3EOfvv2vyfkIoNDB/O6R",
+	"JJKxKz+PIBfLRZxwwLk4ZSMuvVsU8ttK+JPrM0jZjtBpO8P+NVgh6CJic0U3X4Nr0fU6+YqCfMkBgHOO",
+	"GftrSHlkGDLg+B4K1W0oYYgc8eUEcUrgHYf95E4UH1IGkrtiFCoIonGARGscM7CEEUfYmRciKpeQjgWB",
+	"g31OAiKAIWyOoLI5g5iKTGlvGWKSpEfnFddEYOXV6C5I2aD9dsqD5HL73clYOZM8w8n7u0KpViiSFXSV",
+	"Qqt0ilkb1Giah+xTaZumTfT5+JDlo77PvZV938k6m2i1+5k6FczXQMWIm3ltDzo3+gm6eC8Zj05Tqa2h",
+	"3AnuIAWR59wgF8QR+OE6S4+4/tEG/BICYUH+cM1TJa5/5JvCQrjjwQ/XMurA24lv5vAMIcJThQiS/jwf",
+	"LoVeJshFvneL0q5MbYj6bKJlMiQI4JpjgwiIxa3WaxDGwRwRsYt4AaK7QMLE9yJxBfQhb46g2Co8sbtC",
+	"EOIdHNkAsxUiytxFVOwdBHHnZuLHOtj/2bRd9JybbyyiT++bUGvo5JU4MHzBy+EhFB+5S+T+B3oQMBEG",
+	"Vl6jHOz//O3gENP3fIKguwYiu+gV6TSNO4SNF6lonHT6MZEFVKXBDueQOatTxOo0F4tJKI3eBce+stJz",
+	"F80p81LJEHLBsE/t1BmZeyxVoIt5aV3AZxcKRihENUqS4NiPJTqR6CYVB4dFaGgHmZTGkVrOo6zMZ1IG",
+	"OdheyFFZgOG7o/LfxmARH6+MfNRgNBsMl/RDdg93kAaFCojNXlonUeP/T8tPPtOlSttKxuksZAYgnk3k",
+	"8oUi31bSEpTVEf7l5ey18HmKLgDJ3GMEkjUoM5vO/F25e+9BqwiqPRmW502hmyT1dk/A880W6lyv+rr6",
+	"Fsya3g7cyLLfUD1nvPEqVfQpYiClVN6v38Ccf8aIeKhG74pP5ybdOG3Qd3Vb/7Xo/MeGv5s2qeZ8laIj",
+	"qKVp/PSj1FTwuhCiFYI+W1Xq63+K18crJJJ/TfxVpn8OBjkAcMQIYsbkCpC9B+1qpM3eQ1ogV7976Pes",
+	"dxZXbcY2O0QK0rN7GU3Xx7+O7UFB9srtdxGdEsiT5/Lkgh0XERGL4R8+E2GmJNyVHLQlT6qk0pq9Qi/W",
+	"s9rqd+wwZI5oZOmxXsiXYswu/Hb63VCK+N2eruG7KeLlUJB4/hpQjjsAgQ/JEgHFSQAuoRdSJnhuBelK",
+	"nCXXEaQUUO8vxMOCjHjqnMkTFnCgXDN0N8+Tew+8f61SzPiyoA49vg5RopXcdWvxwawib9ktcVasMu2m",
+	"FR8pDi+uChWaX33sRXCkm7LifJ1yIR9z878DALZ4RrYbrAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,80 @@
+// Command releasenotes generates a markdown skeleton of a release note from differences between the schema and its previous version.
+//
+// Usage:
+//
+//	releasenotes [-schema schema/schema.yaml] [-date 2006-01-02] [-o schema/releases/2006-01-02.md] <base>
+//
+// base is either a schema file or a git revision, e.g. the commit of the previous release.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/releasenotes"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/schemadiff"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("releasenotes", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	schemaPath := flags.String("schema", "schema/schema.yaml", "path to the revised schema")
+	date := flags.String("date", time.Now().Format(time.DateOnly), "release date")
+	output := flags.String("o", "", "output file, stdout if empty")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "usage: releasenotes [flags] <base file or git revision>")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("expected 1 argument, got %d", flags.NArg())
+	}
+
+	base, err := schemadiff.LoadVersion(flags.Arg(0), *schemaPath)
+	if err != nil {
+		return fmt.Errorf("base: %w", err)
+	}
+
+	revisionData, err := os.ReadFile(*schemaPath)
+	if err != nil {
+		return err
+	}
+
+	revision, err := schemadiff.Load(revisionData)
+	if err != nil {
+		return fmt.Errorf("revision: %w", err)
+	}
+
+	notes := releasenotes.Build(*date, base, revision)
+
+	if *output == "" {
+		return notes.Render(stdout)
+	}
+
+	f, err := os.OpenFile(*output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if err := notes.Render(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
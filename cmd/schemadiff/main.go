@@ -0,0 +1,110 @@
+// Command schemadiff compares the OpenAPI schema with a previous version and reports changes breaking clients.
+//
+// Usage:
+//
+//	schemadiff [-schema schema/schema.yaml] [-json] [-all] <base>
+//
+// base is either a schema file or a git revision, e.g. main or v1.2.0, at which the schema file is read.
+// The command exits with 1 if there are breaking changes, and with 2 on errors.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/schemadiff"
+)
+
+const (
+	exitBreaking = 1
+	exitError    = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("schemadiff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	schemaPath := flags.String("schema", "schema/schema.yaml", "path to the revised schema")
+	asJSON := flags.Bool("json", false, "print changes as JSON")
+	all := flags.Bool("all", false, "print non-breaking changes too")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "usage: schemadiff [flags] <base file or git revision>")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return exitError
+	}
+
+	base, err := schemadiff.LoadVersion(flags.Arg(0), *schemaPath)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "base: %v\n", err)
+		return exitError
+	}
+
+	revisionData, err := os.ReadFile(*schemaPath)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	revision, err := schemadiff.Load(revisionData)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "revision: %v\n", err)
+		return exitError
+	}
+
+	changes := schemadiff.Compare(base, revision)
+	if !*all {
+		changes = breaking(changes)
+	}
+
+	if err := printChanges(stdout, changes, *asJSON); err != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	if schemadiff.HasBreaking(changes) {
+		return exitBreaking
+	}
+
+	return 0
+}
+
+func breaking(changes []schemadiff.Change) []schemadiff.Change {
+	var result []schemadiff.Change
+	for _, change := range changes {
+		if change.Breaking {
+			result = append(result, change)
+		}
+	}
+
+	return result
+}
+
+func printChanges(w io.Writer, changes []schemadiff.Change, asJSON bool) error {
+	if asJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(append([]schemadiff.Change{}, changes...))
+	}
+
+	for _, change := range changes {
+		if _, err := fmt.Fprintln(w, change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,608 @@
+// Package conformance provides a test suite that checks an api.Server implementation against the contract of the schema.
+// Every operation is exercised through the generated client, so the suite also covers routing and (de)serialization.
+package conformance
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+// Harness is an api.Server under test together with hooks the suite uses to arrange state.
+// The hooks mirror what the real backend does on its own, e.g. tasks created by the Amplifier or broadcasts landing on chain.
+type Harness interface {
+	api.Server
+
+	// RegisterChain makes the chain known to the server
+	RegisterChain(chain string)
+	// RegisterChainInfo makes the chain known to the server if it isn't yet and sets its metadata returned by GetChains and GetChain
+	RegisterChainInfo(info api.ChainInfo)
+	// EnqueueTask makes the task available to GetTasks and GetTask of the task's chain.
+	// Tasks must be returned in the order they're enqueued.
+	EnqueueTask(task api.TaskItem) error
+	// RegisterContract makes the contract known to the server. Queries to the contract must return the response.
+	RegisterContract(contract api.WasmContractAddress, queryResponse api.ContractQueryResponse)
+	// CompleteBroadcast transitions a RECEIVED broadcast to SUCCESS with the given tx events, or to ERROR if broadcastErr isn't nil
+	CompleteBroadcast(broadcastID api.BroadcastID, txEvents []api.WasmEvent, broadcastErr error) error
+}
+
+// Factory creates a fresh Harness. It's called once per test case, so that cases don't share state.
+type Factory func(t *testing.T) Harness
+
+const (
+	chain        = "conformance-chain"
+	unknownChain = "unknown-chain"
+	contract     = "axelar1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq0"
+	unknown      = "axelar1zzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzz"
+)
+
+// Run runs the conformance suite against implementations created by the factory
+func Run(t *testing.T, factory Factory) {
+	t.Helper()
+
+	cases := []struct {
+		name string
+		test func(t *testing.T, h Harness, client api.ClientWithResponsesInterface)
+	}{
+		{"HealthCheck", testHealthCheck},
+		{"GetChains", testGetChains},
+		{"GetTasks/Pagination", testGetTasksPagination},
+		{"GetTasks/DefaultLimit", testGetTasksDefaultLimit},
+		{"GetTasks/Wait", testGetTasksWait},
+		{"GetTasks/TypeFilter", testGetTasksTypeFilter},
+		{"GetTask", testGetTask},
+		{"BatchGetTasks", testBatchGetTasks},
+		{"AckTask", testAckTask},
+		{"StreamTasks", testStreamTasks},
+		{"StreamTasks/LastEventID", testStreamTasksLastEventID},
+		{"UnknownChain", testUnknownChain},
+		{"PublishEvents/PartialErrors", testPublishEventsPartialErrors},
+		{"GetEvent", testGetEvent},
+		{"GetMessageStatus", testGetMessageStatus},
+		{"Payload/RoundTrip", testPayloadRoundTrip},
+		{"Broadcast/StatusTransitions", testBroadcastStatusTransitions},
+		{"QueryContractState", testQueryContractState},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := factory(t)
+			h.RegisterChain(chain)
+
+			tc.test(t, h, newClient(t, h))
+		})
+	}
+}
+
+var errStreamDone = errors.New("stream done")
+
+// streamClient returns the plain client of client, since streams can't be read with the *WithResponse methods
+func streamClient(client api.ClientWithResponsesInterface) api.ClientInterface {
+	return client.(*api.ClientWithResponses).ClientInterface
+}
+
+func newClient(t *testing.T, si api.Server) api.ClientWithResponsesInterface {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, si)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	return client
+}
+
+func testHealthCheck(t *testing.T, _ Harness, client api.ClientWithResponsesInterface) {
+	res, err := client.HealthCheckWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode())
+}
+
+func testGetChains(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	info := api.ChainInfo{
+		Name:           chain,
+		AddressFormat:  api.AddressFormatEIP55,
+		Gateway:        contract,
+		VotingVerifier: "axelar1pppppppppppppppppppppppppppppppppppppppppppppppppppppppppp",
+		MultisigProver: unknown,
+	}
+	h.RegisterChainInfo(info)
+
+	chains, err := client.GetChainsWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, chains.StatusCode())
+	require.NotNil(t, chains.JSON200)
+	assert.Contains(t, chains.JSON200.Chains, info)
+
+	res, err := client.GetChainWithResponse(context.Background(), chain)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.NotNil(t, res.JSON200)
+	assert.Equal(t, info, res.JSON200.Chain)
+
+	res, err = client.GetChainWithResponse(context.Background(), unknownChain)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode())
+	assert.NotNil(t, res.JSON404)
+}
+
+func testGetTasksPagination(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	tasks := enqueueTasks(t, h, 5)
+
+	var received []api.TaskItem
+	var after *uuid.UUID
+	for range len(tasks) {
+		res, err := client.GetTasksWithResponse(context.Background(), chain, &api.GetTasksParams{
+			After: after,
+			Limit: ptr(2),
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		require.NotNil(t, res.JSON200)
+		require.LessOrEqual(t, len(res.JSON200.Tasks), 2, "limit must cap the number of returned tasks")
+
+		if len(res.JSON200.Tasks) == 0 {
+			break
+		}
+
+		received = append(received, res.JSON200.Tasks...)
+		after = &res.JSON200.Tasks[len(res.JSON200.Tasks)-1].ID
+	}
+
+	require.Len(t, received, len(tasks))
+	for i := range tasks {
+		assert.Equal(t, tasks[i].ID, received[i].ID, "tasks must be returned in order")
+		assert.Equal(t, tasks[i].Type, received[i].Type)
+		assert.Equal(t, chain, received[i].Chain)
+	}
+
+	res, err := client.GetTasksWithResponse(context.Background(), chain, &api.GetTasksParams{After: after})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Empty(t, res.JSON200.Tasks, "no tasks must follow the last task")
+}
+
+func testGetTasksDefaultLimit(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	enqueueTasks(t, h, 25)
+
+	res, err := client.GetTasksWithResponse(context.Background(), chain, &api.GetTasksParams{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Len(t, res.JSON200.Tasks, 20)
+}
+
+func testGetTasksWait(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	tasks := enqueueTasks(t, h, 2)
+
+	start := time.Now()
+	res, err := client.GetTasksWithResponse(context.Background(), chain, &api.GetTasksParams{Wait: ptr(30)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Len(t, res.JSON200.Tasks, 2)
+	assert.Less(t, time.Since(start), 10*time.Second, "available tasks must be returned without waiting")
+
+	late := apitest.ExecuteTask().WithItem(func(item *api.TaskItem) { item.Chain = chain }).Build()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		assert.NoError(t, h.EnqueueTask(late))
+	}()
+
+	start = time.Now()
+	res, err = client.GetTasksWithResponse(context.Background(), chain, &api.GetTasksParams{After: &tasks[1].ID, Wait: ptr(30)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.Len(t, res.JSON200.Tasks, 1, "a task enqueued during the wait must be returned")
+	assert.Equal(t, late.ID, res.JSON200.Tasks[0].ID)
+	assert.Less(t, time.Since(start), 10*time.Second, "the request must return as soon as a task arrives")
+}
+
+func testGetTasksTypeFilter(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	onChain := func(item *api.TaskItem) { item.Chain = chain }
+	tasks := []api.TaskItem{
+		apitest.GatewayTransactionTask().WithItem(onChain).Build(),
+		apitest.ExecuteTask().WithItem(onChain).Build(),
+		apitest.RefundTask().WithItem(onChain).Build(),
+		apitest.GatewayTransactionTask().WithItem(onChain).Build(),
+		apitest.ExecuteTask().WithItem(onChain).Build(),
+	}
+	for _, task := range tasks {
+		require.NoError(t, h.EnqueueTask(task))
+	}
+
+	get := func(after *uuid.UUID, types ...api.TaskType) []uuid.UUID {
+		t.Helper()
+
+		res, err := client.GetTasksWithResponse(context.Background(), chain, &api.GetTasksParams{After: after, Type: &types})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode())
+
+		var ids []uuid.UUID
+		for _, task := range res.JSON200.Tasks {
+			ids = append(ids, task.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []uuid.UUID{tasks[1].ID, tasks[4].ID}, get(nil, api.TaskTypeExecute))
+	assert.Equal(t, []uuid.UUID{tasks[1].ID, tasks[2].ID, tasks[4].ID}, get(nil, api.TaskTypeExecute, api.TaskTypeRefund),
+		"repeated types must be combined")
+	assert.Equal(t, []uuid.UUID{tasks[3].ID}, get(&tasks[0].ID, api.TaskTypeGatewayTransaction), "the cursor is the last task of the filtered view")
+	assert.Equal(t, []uuid.UUID{tasks[4].ID}, get(&tasks[2].ID, api.TaskTypeExecute), "the cursor may be a task of another type")
+	assert.Empty(t, get(&tasks[4].ID, api.TaskTypeExecute))
+}
+
+func testGetTask(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	tasks := enqueueTasks(t, h, 3)
+
+	res, err := client.GetTaskWithResponse(context.Background(), chain, tasks[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Equal(t, tasks[1].ID, res.JSON200.Task.ID)
+	assert.Equal(t, tasks[1].Type, res.JSON200.Task.Type)
+	assert.JSONEq(t, string(mustMarshal(t, tasks[1].Task)), string(mustMarshal(t, res.JSON200.Task.Task)))
+
+	res, err = client.GetTaskWithResponse(context.Background(), chain, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode())
+	assert.NotNil(t, res.JSON404)
+}
+
+func testBatchGetTasks(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	tasks := enqueueTasks(t, h, 3)
+	missing := uuid.New()
+
+	res, err := client.BatchGetTasksWithResponse(context.Background(), chain, api.BatchGetTasksRequest{
+		Ids: []api.TaskItemID{tasks[2].ID, missing, tasks[0].ID, tasks[2].ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.NotNil(t, res.JSON200)
+	require.Len(t, res.JSON200.Tasks, 2, "duplicate IDs must be returned once")
+	assert.Equal(t, tasks[2].ID, res.JSON200.Tasks[0].ID, "tasks must keep the order of the requested IDs")
+	assert.Equal(t, tasks[0].ID, res.JSON200.Tasks[1].ID)
+	assert.Equal(t, tasks[0].Type, res.JSON200.Tasks[1].Type)
+	assert.Equal(t, []api.TaskItemID{missing}, res.JSON200.Missing)
+
+	empty, err := client.BatchGetTasksWithResponse(context.Background(), chain, api.BatchGetTasksRequest{Ids: []api.TaskItemID{}})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, empty.StatusCode())
+
+	unknown, err := client.BatchGetTasksWithResponse(context.Background(), unknownChain, api.BatchGetTasksRequest{Ids: []api.TaskItemID{missing}})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, unknown.StatusCode())
+	assert.NotNil(t, unknown.JSON404)
+}
+
+func testAckTask(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	task := enqueueTasks(t, h, 1)[0]
+
+	ack := func(chain string, taskItemID uuid.UUID, status api.TaskAckStatus) int {
+		t.Helper()
+
+		res, err := client.AckTaskWithResponse(context.Background(), chain, taskItemID, api.AckTaskRequest{Status: status, Details: ptr("details")})
+		require.NoError(t, err)
+		return res.StatusCode()
+	}
+
+	assert.Equal(t, http.StatusNoContent, ack(chain, task.ID, api.TaskAckStatusProcessing))
+	assert.Equal(t, http.StatusNoContent, ack(chain, task.ID, api.TaskAckStatusFailed))
+	assert.Equal(t, http.StatusNoContent, ack(chain, task.ID, api.TaskAckStatusProcessing), "failed tasks can be retried")
+	assert.Equal(t, http.StatusNoContent, ack(chain, task.ID, api.TaskAckStatusDone))
+	assert.Equal(t, http.StatusNoContent, ack(chain, task.ID, api.TaskAckStatusDone), "repeating DONE must be a no-op")
+	assert.Equal(t, http.StatusConflict, ack(chain, task.ID, api.TaskAckStatusProcessing), "DONE must be final")
+
+	assert.Equal(t, http.StatusBadRequest, ack(chain, task.ID, "UNKNOWN"))
+	assert.Equal(t, http.StatusNotFound, ack(chain, uuid.New(), api.TaskAckStatusDone))
+	assert.Equal(t, http.StatusNotFound, ack(unknownChain, task.ID, api.TaskAckStatusDone))
+}
+
+func testStreamTasks(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	tasks := enqueueTasks(t, h, 3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var live api.TaskItem
+	var received []uuid.UUID
+	err := api.ConsumeTasks(ctx, streamClient(client), chain, func(_ context.Context, task api.TaskItem) error {
+		received = append(received, task.ID)
+
+		switch len(received) {
+		case 2:
+			// tasks enqueued while the stream is open must be pushed
+			live = enqueueTasks(t, h, 1)[0]
+			return nil
+		case 3:
+			return errStreamDone
+		default:
+			return nil
+		}
+	}, api.WithStreamAfter(tasks[0].ID))
+
+	require.ErrorIs(t, err, errStreamDone)
+	assert.Equal(t, []uuid.UUID{tasks[1].ID, tasks[2].ID, live.ID}, received, "tasks after the cursor must be streamed in order")
+
+	err = api.ConsumeTasks(ctx, streamClient(client), unknownChain, func(context.Context, api.TaskItem) error { return nil })
+	assert.ErrorIs(t, err, api.ErrStreamTasks)
+}
+
+func testStreamTasksLastEventID(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	tasks := enqueueTasks(t, h, 3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	res, err := streamClient(client).StreamTasks(ctx, chain, &api.StreamTasksParams{After: &tasks[0].ID, LastEventID: &tasks[1].ID})
+	require.NoError(t, err)
+	defer func() { _ = res.Body.Close() }()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	reader := bufio.NewReader(res.Body)
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		if id, ok := strings.CutPrefix(strings.TrimSpace(line), "id:"); ok {
+			assert.Equal(t, tasks[2].ID.String(), strings.TrimSpace(id), "Last-Event-ID must take precedence over after")
+			return
+		}
+	}
+}
+
+func testUnknownChain(t *testing.T, _ Harness, client api.ClientWithResponsesInterface) {
+	tasks, err := client.GetTasksWithResponse(context.Background(), unknownChain, &api.GetTasksParams{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, tasks.StatusCode())
+	assert.NotNil(t, tasks.JSON404)
+
+	task, err := client.GetTaskWithResponse(context.Background(), unknownChain, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, task.StatusCode())
+	assert.NotNil(t, task.JSON404)
+
+	events, err := client.PublishEventsWithResponse(context.Background(), unknownChain, api.PublishEventsRequest{
+		Events: []api.Event{callEvent(t)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, events.StatusCode())
+	assert.NotNil(t, events.JSON404)
+}
+
+func testPublishEventsPartialErrors(t *testing.T, _ Harness, client api.ClientWithResponsesInterface) {
+	res, err := client.PublishEventsWithResponse(context.Background(), chain, api.PublishEventsRequest{
+		Events: []api.Event{callEvent(t), invalidEvent(t), callEvent(t)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.NotNil(t, res.JSON200)
+	require.Len(t, res.JSON200.Results, 3, "every event must have a result")
+
+	byIndex := make(map[int]api.PublishEventResultItem)
+	for _, result := range res.JSON200.Results {
+		accepted, err := result.AsPublishEventAcceptedResult()
+		require.NoError(t, err)
+		byIndex[accepted.Index] = result
+	}
+	require.Len(t, byIndex, 3, "results must be indexed by the position of the event in the request")
+
+	for _, i := range []int{0, 2} {
+		accepted, err := byIndex[i].AsPublishEventAcceptedResult()
+		require.NoError(t, err)
+		assert.Equal(t, api.PublishEventStatusAccepted, accepted.Status, "valid event %d must be accepted", i)
+	}
+
+	rejected, err := byIndex[1].AsPublishEventErrorResult()
+	require.NoError(t, err)
+	assert.Equal(t, api.PublishEventStatusError, rejected.Status)
+	assert.NotEmpty(t, rejected.Error)
+	assert.False(t, rejected.Retriable, "invalid event must not be retriable")
+}
+
+func testGetEvent(t *testing.T, _ Harness, client api.ClientWithResponsesInterface) {
+	event := apitest.CallEvent().With(func(e *api.CallEvent) {
+		e.EventID = "tx:" + chain + ":0xabc:3:CALL"
+	}).Build()
+	message := funcs.Must(event.AsCallEvent()).Message
+
+	published, err := client.PublishEventsWithResponse(context.Background(), chain, api.PublishEventsRequest{Events: []api.Event{event}})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, published.StatusCode())
+
+	res, err := client.GetEventWithResponse(context.Background(), chain, "tx:"+chain+":0xabc:3:CALL")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.NotNil(t, res.JSON200)
+	assert.JSONEq(t, string(mustMarshal(t, event)), string(mustMarshal(t, res.JSON200.Event)))
+	assert.Contains(t, []api.EventIngestionStatus{
+		api.EventIngestionStatusReceived, api.EventIngestionStatusIndexed, api.EventIngestionStatusFailed,
+	}, res.JSON200.Status)
+	assert.False(t, res.JSON200.ReceivedAt.IsZero())
+	if assert.NotNil(t, res.JSON200.CrossChainID, "CALL event must be linked to its message") {
+		assert.Equal(t, api.CrossChainID{SourceChain: message.SourceChain, MessageID: message.MessageID}, *res.JSON200.CrossChainID)
+	}
+
+	res, err = client.GetEventWithResponse(context.Background(), chain, "unknown-event")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode())
+	assert.NotNil(t, res.JSON404)
+
+	res, err = client.GetEventWithResponse(context.Background(), unknownChain, "tx:"+chain+":0xabc:3:CALL")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode())
+	assert.NotNil(t, res.JSON404)
+}
+
+func testGetMessageStatus(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	const destinationChain = "conformance-destination-chain"
+	h.RegisterChain(destinationChain)
+
+	call := apitest.CallEvent().With(func(e *api.CallEvent) {
+		e.Message.SourceChain = chain
+		e.DestinationChain = destinationChain
+	})
+	message := call.Value().Message
+	id := api.CrossChainID{SourceChain: chain, MessageID: message.MessageID}
+	publish(t, client, chain, call.Build())
+
+	status := messageStatus(t, client, id)
+	assert.Equal(t, id, status.CrossChainID)
+	assert.Equal(t, api.MessageStageCalled, status.Stage)
+	assert.NotNil(t, status.CalledAt)
+	assert.Empty(t, status.TaskIDs)
+
+	task := apitest.ExecuteTask().
+		With(func(task *api.ExecuteTask) { task.Message = message }).
+		WithItem(func(item *api.TaskItem) { item.Chain = destinationChain }).
+		Build()
+	require.NoError(t, h.EnqueueTask(task))
+
+	approved := apitest.MessageApprovedEvent().With(func(e *api.MessageApprovedEvent) { e.Message = message })
+	publish(t, client, destinationChain, approved.Build())
+
+	status = messageStatus(t, client, id)
+	assert.Equal(t, api.MessageStageApproved, status.Stage)
+	assert.NotNil(t, status.ApprovedAt)
+	assert.Equal(t, []api.TaskItemID{task.ID}, status.TaskIDs)
+	if assert.Len(t, status.Costs, 1, "cost of MESSAGE_APPROVED must be recorded") {
+		assert.Equal(t, funcs.Must(approved.Value().Cost.AsUnsignedToken()), status.Costs[0].Token)
+	}
+
+	publish(t, client, destinationChain, apitest.MessageExecutedEventV2().With(func(e *api.MessageExecutedEventV2) { e.CrossChainID = id }).Build())
+
+	status = messageStatus(t, client, id)
+	assert.Equal(t, api.MessageStageExecuted, status.Stage)
+	assert.NotNil(t, status.ExecutedAt)
+	assert.Len(t, status.Costs, 2)
+	assert.False(t, status.UpdatedAt.Before(*status.CalledAt))
+
+	res, err := client.GetMessageStatusWithResponse(context.Background(), chain, "unknown-message")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode())
+	assert.NotNil(t, res.JSON404)
+}
+
+func testPayloadRoundTrip(t *testing.T, _ Harness, client api.ClientWithResponsesInterface) {
+	payload := []byte("conformance payload")
+
+	stored, err := client.StorePayloadWithBodyWithResponse(context.Background(), "application/octet-stream", bytes.NewReader(payload))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, stored.StatusCode())
+	require.NotNil(t, stored.JSON200)
+	assert.Equal(t, "0x"+hex.EncodeToString(apitest.Keccak256(payload)), stored.JSON200.Keccak256)
+
+	res, err := client.GetPayloadWithResponse(context.Background(), stored.JSON200.Keccak256)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	assert.Equal(t, payload, res.Body)
+
+	res, err = client.GetPayloadWithResponse(context.Background(), "0x"+string(bytes.Repeat([]byte("0"), 64)))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode())
+}
+
+func testBroadcastStatusTransitions(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	request, err := api.WasmRequestFromObject(map[string]any{"verify_messages": []any{}})
+	require.NoError(t, err)
+
+	broadcastIDs := make([]api.BroadcastID, 2)
+	for i := range broadcastIDs {
+		res, err := client.BroadcastMsgExecuteContractWithResponse(context.Background(), contract, request)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode())
+		require.NotNil(t, res.JSON200)
+		broadcastIDs[i] = res.JSON200.BroadcastID
+
+		status := broadcastStatus(t, client, broadcastIDs[i])
+		assert.Equal(t, api.BroadcastStatusReceived, status.Status)
+		assert.False(t, status.ReceivedAt.IsZero())
+		assert.Nil(t, status.CompletedAt)
+	}
+
+	txEvents := []api.WasmEvent{{
+		Type:       "wasm-messages_poll_started",
+		Attributes: []api.WasmEventAttribute{{Key: "poll_id", Value: `"1"`}},
+	}}
+	require.NoError(t, h.CompleteBroadcast(broadcastIDs[0], txEvents, nil))
+	require.NoError(t, h.CompleteBroadcast(broadcastIDs[1], nil, errors.New("out of gas")))
+
+	succeeded := broadcastStatus(t, client, broadcastIDs[0])
+	assert.Equal(t, api.BroadcastStatusSuccess, succeeded.Status)
+	require.NotNil(t, succeeded.CompletedAt)
+	assert.False(t, succeeded.CompletedAt.Before(succeeded.ReceivedAt))
+	assert.NotNil(t, succeeded.TxHash)
+	require.NotNil(t, succeeded.TxEvents)
+	assert.Equal(t, txEvents, *succeeded.TxEvents)
+
+	failed := broadcastStatus(t, client, broadcastIDs[1])
+	assert.Equal(t, api.BroadcastStatusError, failed.Status)
+	assert.NotNil(t, failed.CompletedAt)
+	require.NotNil(t, failed.Error)
+	assert.NotEmpty(t, *failed.Error)
+
+	res, err := client.GetMsgExecuteContractBroadcastStatusWithResponse(context.Background(), contract, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode())
+
+	res, err = client.GetMsgExecuteContractBroadcastStatusWithResponse(context.Background(), unknown, broadcastIDs[0])
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode(), "broadcast must only be found under the contract it was sent to")
+}
+
+func testQueryContractState(t *testing.T, h Harness, client api.ClientWithResponsesInterface) {
+	h.RegisterContract(contract, api.ContractQueryResponse{"threshold": "2"})
+
+	request, err := api.WasmRequestFromObject(map[string]any{"current_verifier_set": map[string]any{}})
+	require.NoError(t, err)
+
+	res, err := client.QueryContractStateWithResponse(context.Background(), contract, request)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.NotNil(t, res.JSON200)
+	assert.Equal(t, api.ContractQueryResponse{"threshold": "2"}, *res.JSON200)
+
+	res, err = client.QueryContractStateWithResponse(context.Background(), unknown, request)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode())
+	assert.NotNil(t, res.JSON404)
+}
+
+func messageStatus(t *testing.T, client api.ClientWithResponsesInterface, id api.CrossChainID) api.GetMessageStatusResult {
+	t.Helper()
+
+	res, err := client.GetMessageStatusWithResponse(context.Background(), id.SourceChain, id.MessageID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.NotNil(t, res.JSON200)
+
+	return *res.JSON200
+}
+
+func broadcastStatus(t *testing.T, client api.ClientWithResponsesInterface, broadcastID api.BroadcastID) api.BroadcastStatusResponse {
+	t.Helper()
+
+	res, err := client.GetMsgExecuteContractBroadcastStatusWithResponse(context.Background(), contract, broadcastID)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.NotNil(t, res.JSON200)
+
+	return *res.JSON200
+}
@@ -0,0 +1,14 @@
+package conformance_test
+
+import (
+	"testing"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/conformance"
+	"github.com/axelarnetwork/amplifier-relayer-api/memserver"
+)
+
+func TestMemServer(t *testing.T) {
+	conformance.Run(t, func(*testing.T) conformance.Harness {
+		return memserver.New()
+	})
+}
@@ -0,0 +1,77 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+)
+
+// enqueueTasks enqueues n tasks of random types on the conformance chain and returns them in order
+func enqueueTasks(t *testing.T, h Harness, n int) []api.TaskItem {
+	t.Helper()
+
+	r := rand.New(rand.NewSource(int64(n)))
+
+	tasks := make([]api.TaskItem, n)
+	for i := range tasks {
+		tasks[i] = apitest.RandomTaskItem(r)
+		tasks[i].Chain = chain
+		require.NoError(t, h.EnqueueTask(tasks[i]))
+	}
+
+	return tasks
+}
+
+func callEvent(t *testing.T) api.Event {
+	t.Helper()
+
+	event := apitest.CallEvent().Build()
+	require.NoError(t, event.Validate())
+
+	return event
+}
+
+// publish publishes the events to the chain and requires all of them to be accepted
+func publish(t *testing.T, client api.ClientWithResponsesInterface, chain string, events ...api.Event) {
+	t.Helper()
+
+	res, err := client.PublishEventsWithResponse(context.Background(), chain, api.PublishEventsRequest{Events: events})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode())
+	require.NotNil(t, res.JSON200)
+
+	for _, result := range res.JSON200.Results {
+		accepted, err := result.AsPublishEventAcceptedResult()
+		require.NoError(t, err)
+		require.Equal(t, api.PublishEventStatusAccepted, accepted.Status)
+	}
+}
+
+// invalidEvent returns an event every implementation must reject, because eventID is required
+func invalidEvent(t *testing.T) api.Event {
+	t.Helper()
+
+	return apitest.CallEvent().With(func(e *api.CallEvent) {
+		e.EventID = ""
+	}).Build()
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return data
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
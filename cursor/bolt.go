@@ -0,0 +1,71 @@
+package cursor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	bolt "go.etcd.io/bbolt"
+)
+
+var bucket = []byte("cursors")
+
+var _ Store = (*BoltStore)(nil)
+
+// BoltStore is a Store keeping cursors in a bucket of a bbolt database, e.g. the database the relayer keeps its own state in
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore creates a BoltStore in db. The caller keeps ownership of db and closes it.
+func NewBoltStore(db *bolt.DB) (*BoltStore, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cursor bucket: %w", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Load implements Store
+func (s *BoltStore) Load(_ context.Context, chain string) (*uuid.UUID, error) {
+	var after *uuid.UUID
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(bucket).Get([]byte(chain))
+		if value == nil {
+			return nil
+		}
+
+		cursor, err := uuid.FromBytes(value)
+		if err != nil {
+			return fmt.Errorf("failed to parse cursor of chain %s: %w", chain, err)
+		}
+
+		after = &cursor
+		return nil
+	})
+
+	return after, err
+}
+
+// Save implements Store
+func (s *BoltStore) Save(_ context.Context, chain string, after uuid.UUID) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return SaveTx(tx, chain, after)
+	})
+}
+
+// SaveTx saves the cursor of the chain within tx, so that handlers keeping their state in the same database
+// can commit the cursor atomically with the effects of a task, see poller.CursorFromContext
+func SaveTx(tx *bolt.Tx, chain string, after uuid.UUID) error {
+	b := tx.Bucket(bucket)
+	if b == nil {
+		return errors.New("cursor bucket doesn't exist")
+	}
+
+	return b.Put([]byte(chain), after[:])
+}
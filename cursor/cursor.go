@@ -0,0 +1,52 @@
+// Package cursor persists the after cursors of GetTasks per chain, so that a restarted relayer neither reprocesses nor skips tasks.
+package cursor
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Store keeps the cursor of every chain independently. Implementations are safe for concurrent use.
+type Store interface {
+	// Load returns the cursor of the chain, or nil if none was saved
+	Load(ctx context.Context, chain string) (*uuid.UUID, error)
+	// Save durably replaces the cursor of the chain
+	Save(ctx context.Context, chain string, after uuid.UUID) error
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// MemoryStore is a Store that keeps cursors in memory, e.g. for tests
+type MemoryStore struct {
+	mu      sync.Mutex
+	cursors map[string]uuid.UUID
+}
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{cursors: make(map[string]uuid.UUID)}
+}
+
+// Load implements Store
+func (s *MemoryStore) Load(_ context.Context, chain string) (*uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	after, ok := s.cursors[chain]
+	if !ok {
+		return nil, nil
+	}
+
+	return &after, nil
+}
+
+// Save implements Store
+func (s *MemoryStore) Save(_ context.Context, chain string, after uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cursors[chain] = after
+	return nil
+}
@@ -0,0 +1,92 @@
+package cursor_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/cursor"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+func openBolt(t *testing.T, path string) *bolt.DB {
+	t.Helper()
+
+	db := funcs.Must(bolt.Open(path, 0o600, nil))
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+func TestStores(t *testing.T) {
+	testCases := []struct {
+		name string
+		// open returns the store, and a function reopening it as after a restart
+		open func(t *testing.T) (cursor.Store, func() cursor.Store)
+	}{
+		{"memory", func(t *testing.T) (cursor.Store, func() cursor.Store) {
+			store := cursor.NewMemoryStore()
+			return store, func() cursor.Store { return store }
+		}},
+		{"file", func(t *testing.T) (cursor.Store, func() cursor.Store) {
+			dir := t.TempDir()
+			return funcs.Must(cursor.NewFileStore(dir)), func() cursor.Store { return funcs.Must(cursor.NewFileStore(dir)) }
+		}},
+		{"bolt", func(t *testing.T) (cursor.Store, func() cursor.Store) {
+			path := filepath.Join(t.TempDir(), "relayer.db")
+			db := openBolt(t, path)
+			return funcs.Must(cursor.NewBoltStore(db)), func() cursor.Store {
+				require.NoError(t, db.Close())
+				db = openBolt(t, path)
+				return funcs.Must(cursor.NewBoltStore(db))
+			}
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store, reopen := tc.open(t)
+
+			after := funcs.Must(store.Load(ctx, "ethereum"))
+			assert.Nil(t, after)
+
+			first, second, other := uuid.New(), uuid.New(), uuid.New()
+			require.NoError(t, store.Save(ctx, "ethereum", first))
+			require.NoError(t, store.Save(ctx, "ethereum", second))
+			require.NoError(t, store.Save(ctx, "cosmos/hub", other))
+
+			store = reopen()
+			assert.Equal(t, second, *funcs.Must(store.Load(ctx, "ethereum")))
+			assert.Equal(t, other, *funcs.Must(store.Load(ctx, "cosmos/hub")))
+			assert.Nil(t, funcs.Must(store.Load(ctx, "avalanche")))
+		})
+	}
+}
+
+func TestSaveTx(t *testing.T) {
+	ctx := context.Background()
+	db := openBolt(t, filepath.Join(t.TempDir(), "relayer.db"))
+	store := funcs.Must(cursor.NewBoltStore(db))
+
+	after := uuid.New()
+	err := db.Update(func(tx *bolt.Tx) error {
+		if err := cursor.SaveTx(tx, "ethereum", after); err != nil {
+			return err
+		}
+
+		return assert.AnError
+	})
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, funcs.Must(store.Load(ctx, "ethereum")), "cursor must roll back with the transaction")
+
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		return cursor.SaveTx(tx, "ethereum", after)
+	}))
+	assert.Equal(t, after, *funcs.Must(store.Load(ctx, "ethereum")))
+}
@@ -0,0 +1,78 @@
+package cursor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/atomicfile"
+)
+
+var _ Store = (*FileStore)(nil)
+
+type fileCursor struct {
+	Chain string    `json:"chain"`
+	After uuid.UUID `json:"after"`
+}
+
+// FileStore is a Store keeping the cursor of every chain in its own JSON file in a directory,
+// so that relayers of different chains can share the directory.
+type FileStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileStore creates a FileStore in dir. The directory is created if it doesn't exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cursor directory: %w", err)
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Load implements Store
+func (s *FileStore) Load(_ context.Context, chain string) (*uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path(chain))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor fileCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("failed to parse cursor of chain %s: %w", chain, err)
+	}
+
+	return &cursor.After, nil
+}
+
+// Save implements Store. A crash leaves either the old or the new cursor.
+func (s *FileStore) Save(_ context.Context, chain string, after uuid.UUID) error {
+	data, err := json.Marshal(fileCursor{Chain: chain, After: after})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return atomicfile.Write(s.path(chain), data)
+}
+
+// path escapes the chain name, so that any name maps to a file in the directory
+func (s *FileStore) path(chain string) string {
+	return filepath.Join(s.dir, url.PathEscape(chain)+".json")
+}
@@ -0,0 +1,70 @@
+// Package deadletter keeps a local record of tasks that failed, together with the error and the CANNOT_EXECUTE_TASK event
+// that was published for them. Operators can list and inspect entries, and replay them into a task handler once the cause is fixed.
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+// ErrNotFound is an error when the store has no entry for a task
+var ErrNotFound = errors.New("dead-letter entry not found")
+
+// Entry is a failed task
+type Entry struct {
+	Task api.TaskItem `json:"task"`
+	// Error is the error of the last attempt
+	Error string `json:"error"`
+	// Attempts counts failed attempts, including replays
+	Attempts int `json:"attempts"`
+	// Event is the CANNOT_EXECUTE_TASK event published for the task, nil if none was published
+	Event         *api.CannotExecuteTaskEvent `json:"event,omitempty"`
+	FirstFailedAt time.Time                   `json:"firstFailedAt"`
+	LastFailedAt  time.Time                   `json:"lastFailedAt"`
+}
+
+// Store persists entries keyed by task ID
+type Store interface {
+	// Put creates or replaces the entry of the task
+	Put(ctx context.Context, entry Entry) error
+	// Get returns the entry of the task, or ErrNotFound
+	Get(ctx context.Context, taskItemID uuid.UUID) (Entry, error)
+	// List returns all entries ordered by the time of the first failure
+	List(ctx context.Context) ([]Entry, error)
+	// Delete removes the entry of the task. Deleting a missing entry isn't an error.
+	Delete(ctx context.Context, taskItemID uuid.UUID) error
+}
+
+// Record stores a failed attempt of the task. Attempts of a task that already has an entry are counted up,
+// and the event is kept if the new attempt didn't publish one.
+func Record(ctx context.Context, store Store, task api.TaskItem, cause error, event *api.CannotExecuteTaskEvent) (Entry, error) {
+	now := time.Now().UTC()
+
+	entry, err := store.Get(ctx, task.ID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		entry = Entry{FirstFailedAt: now}
+	case err != nil:
+		return Entry{}, err
+	}
+
+	entry.Task = task
+	entry.Error = cause.Error()
+	entry.Attempts++
+	entry.LastFailedAt = now
+	if event != nil {
+		entry.Event = event
+	}
+
+	if err := store.Put(ctx, entry); err != nil {
+		return Entry{}, fmt.Errorf("failed to store dead-letter entry of task %s: %w", task.ID, err)
+	}
+
+	return entry, nil
+}
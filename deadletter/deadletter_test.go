@@ -0,0 +1,175 @@
+package deadletter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/deadletter"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/memserver"
+	"github.com/axelarnetwork/amplifier-relayer-api/poller"
+)
+
+func failing(failed map[uuid.UUID]bool) poller.TaskHandler {
+	return poller.TaskHandlerFunc(func(_ context.Context, task api.TaskItem) error {
+		if failed[task.ID] {
+			return errors.New("insufficient balance")
+		}
+		return nil
+	})
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "dead-letter")
+	store := funcs.Must(deadletter.NewFileStore(dir))
+
+	_, err := store.Get(ctx, uuid.New())
+	assert.ErrorIs(t, err, deadletter.ErrNotFound)
+
+	first := apitest.ExecuteTask().Build()
+	second := apitest.RefundTask().Build()
+
+	funcs.Must(deadletter.Record(ctx, store, first, errors.New("first"), nil))
+	funcs.Must(deadletter.Record(ctx, store, second, errors.New("second"), nil))
+	entry := funcs.Must(deadletter.Record(ctx, store, first, errors.New("again"), nil))
+	assert.Equal(t, 2, entry.Attempts)
+	assert.Equal(t, "again", entry.Error)
+
+	// a new store over the same directory sees the same entries
+	reopened := funcs.Must(deadletter.NewFileStore(dir))
+	entries := funcs.Must(reopened.List(ctx))
+	require.Len(t, entries, 2)
+	assert.Equal(t, first.ID, entries[0].Task.ID)
+	assert.Equal(t, second.ID, entries[1].Task.ID)
+	assert.Equal(t, api.TaskTypeExecute, entries[0].Task.Type)
+	assert.JSONEq(t, string(funcs.Must(first.RawTask())), string(funcs.Must(entries[0].Task.RawTask())))
+
+	require.NoError(t, reopened.Delete(ctx, first.ID))
+	require.NoError(t, reopened.Delete(ctx, first.ID))
+	assert.Len(t, funcs.Must(store.List(ctx)), 1)
+
+	files := funcs.Must(os.ReadDir(dir))
+	assert.Len(t, files, 1, "no temporary files must be left behind")
+}
+
+func TestNewHandler(t *testing.T) {
+	ctx := context.Background()
+	store := funcs.Must(deadletter.NewFileStore(t.TempDir()))
+
+	ok := apitest.ExecuteTask().Build()
+	bad := apitest.ExecuteTask().Build()
+
+	var reported []uuid.UUID
+	handler := deadletter.NewHandler(failing(map[uuid.UUID]bool{bad.ID: true}), store,
+		func(_ context.Context, task api.TaskItem, cause error) (*api.CannotExecuteTaskEvent, error) {
+			reported = append(reported, task.ID)
+			return &api.CannotExecuteTaskEvent{
+				EventID:    "event-" + task.ID.String(),
+				TaskItemID: task.ID,
+				Reason:     api.CannotExecuteTaskReasonError,
+				Details:    cause.Error(),
+			}, nil
+		})
+
+	require.NoError(t, handler.HandleTask(ctx, ok))
+	err := handler.HandleTask(ctx, bad)
+	require.ErrorIs(t, err, poller.ErrTaskFailed, "failed task must not stop the poller")
+	assert.ErrorContains(t, err, "insufficient balance")
+	assert.Equal(t, []uuid.UUID{bad.ID}, reported)
+
+	entry := funcs.Must(store.Get(ctx, bad.ID))
+	assert.Equal(t, 1, entry.Attempts)
+	assert.Equal(t, "insufficient balance", entry.Error)
+	require.NotNil(t, entry.Event)
+	assert.Equal(t, "event-"+bad.ID.String(), entry.Event.EventID)
+
+	t.Run("should record failure to report", func(t *testing.T) {
+		task := apitest.ExecuteTask().Build()
+		handler := deadletter.NewHandler(failing(map[uuid.UUID]bool{task.ID: true}), store,
+			func(context.Context, api.TaskItem, error) (*api.CannotExecuteTaskEvent, error) {
+				return nil, errors.New("backend unavailable")
+			})
+
+		require.ErrorIs(t, handler.HandleTask(ctx, task), poller.ErrTaskFailed)
+		entry := funcs.Must(store.Get(ctx, task.ID))
+		assert.Contains(t, entry.Error, "insufficient balance")
+		assert.Contains(t, entry.Error, "backend unavailable")
+		assert.Nil(t, entry.Event)
+	})
+}
+
+func TestNewHandler_Interrupted(t *testing.T) {
+	store := funcs.Must(deadletter.NewFileStore(t.TempDir()))
+
+	server := memserver.New(memserver.WithChains("ethereum"))
+	require.NoError(t, server.EnqueueTask(apitest.ExecuteTask().WithItem(func(item *api.TaskItem) { item.Chain = "ethereum" }).Build()))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, server)
+	httpServer := httptest.NewServer(router)
+	t.Cleanup(httpServer.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the relayer shuts down while the task is handled
+	shutdown := poller.TaskHandlerFunc(func(ctx context.Context, _ api.TaskItem) error {
+		cancel()
+		<-ctx.Done()
+		return fmt.Errorf("failed to broadcast: %w", ctx.Err())
+	})
+
+	client := funcs.Must(api.NewClientWithResponses(httpServer.URL))
+	p := poller.New(client, "ethereum", deadletter.NewHandler(shutdown, store, nil))
+
+	_, err := p.Poll(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, poller.ErrTaskFailed)
+	assert.Nil(t, p.Cursor(), "interrupted task must not be committed")
+	assert.Empty(t, funcs.Must(store.List(context.Background())), "interrupted task must not be recorded")
+}
+
+func TestReplay(t *testing.T) {
+	ctx := context.Background()
+	store := funcs.Must(deadletter.NewFileStore(t.TempDir()))
+
+	fixed := apitest.ExecuteTask().Build()
+	broken := apitest.VerifyTask().Build()
+	event := &api.CannotExecuteTaskEvent{EventID: "event", TaskItemID: broken.ID, Reason: api.CannotExecuteTaskReasonError}
+	funcs.Must(deadletter.Record(ctx, store, fixed, errors.New("misconfigured"), nil))
+	funcs.Must(deadletter.Record(ctx, store, broken, errors.New("misconfigured"), event))
+
+	result := funcs.Must(deadletter.Replay(ctx, store, failing(map[uuid.UUID]bool{broken.ID: true})))
+	assert.Equal(t, []uuid.UUID{fixed.ID}, result.Succeeded)
+	assert.Equal(t, []uuid.UUID{broken.ID}, result.Failed)
+
+	_, err := store.Get(ctx, fixed.ID)
+	assert.ErrorIs(t, err, deadletter.ErrNotFound)
+
+	entry := funcs.Must(store.Get(ctx, broken.ID))
+	assert.Equal(t, 2, entry.Attempts)
+	assert.Equal(t, event, entry.Event, "event of the first failure must be kept")
+
+	t.Run("should replay selected entries", func(t *testing.T) {
+		result := funcs.Must(deadletter.Replay(ctx, store, failing(nil), broken.ID))
+		assert.Equal(t, []uuid.UUID{broken.ID}, result.Succeeded)
+		assert.Empty(t, funcs.Must(store.List(ctx)))
+
+		_, err := deadletter.Replay(ctx, store, failing(nil), broken.ID)
+		assert.ErrorIs(t, err, deadletter.ErrNotFound)
+	})
+}
@@ -0,0 +1,125 @@
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/atomicfile"
+)
+
+const entryExt = ".json"
+
+var _ Store = (*FileStore)(nil)
+
+// FileStore is a Store keeping every entry in its own JSON file in a directory, so that operators can inspect entries with ordinary tools.
+// It's safe for concurrent use within a process.
+type FileStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileStore creates a FileStore in dir. The directory is created if it doesn't exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create dead-letter directory: %w", err)
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Put implements Store. Entries are replaced atomically, so that a crash never leaves a partial entry.
+func (s *FileStore) Put(_ context.Context, entry Entry) error {
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return atomicfile.Write(s.path(entry.Task.ID), data)
+}
+
+// Get implements Store
+func (s *FileStore) Get(_ context.Context, taskItemID uuid.UUID) (Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.read(s.path(taskItemID))
+}
+
+// List implements Store
+func (s *FileStore) List(_ context.Context) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || filepath.Ext(file.Name()) != entryExt {
+			continue
+		}
+
+		entry, err := s.read(filepath.Join(s.dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	slices.SortStableFunc(entries, func(a, b Entry) int {
+		return a.FirstFailedAt.Compare(b.FirstFailedAt)
+	})
+
+	return entries, nil
+}
+
+// Delete implements Store
+func (s *FileStore) Delete(_ context.Context, taskItemID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := os.Remove(s.path(taskItemID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.SyncDir(s.dir)
+}
+
+func (s *FileStore) path(taskItemID uuid.UUID) string {
+	return filepath.Join(s.dir, taskItemID.String()+entryExt)
+}
+
+func (s *FileStore) read(path string) (Entry, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Entry{}, fmt.Errorf("%w: %s", ErrNotFound, strings.TrimSuffix(filepath.Base(path), entryExt))
+	}
+	if err != nil {
+		return Entry{}, err
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return Entry{}, fmt.Errorf("failed to parse dead-letter entry %s: %w", path, err)
+	}
+
+	return entry, nil
+}
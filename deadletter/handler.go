@@ -0,0 +1,106 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/poller"
+)
+
+// OnFailure is called when a task fails, typically to publish a CANNOT_EXECUTE_TASK event. The returned event is stored with the entry.
+type OnFailure func(ctx context.Context, task api.TaskItem, cause error) (*api.CannotExecuteTaskEvent, error)
+
+// NewHandler wraps next, so that failed tasks are recorded in the store instead of stopping the poller.
+// If onFailure fails, the failure is recorded with its error, and the task can still be replayed.
+// Recorded failures are returned wrapping poller.ErrTaskFailed, so that the poller acknowledges them as FAILED and carries on.
+// Other errors are returned only if the entry can't be stored, so that no failed task goes unrecorded.
+// Tasks interrupted by ctx, e.g. on shutdown, aren't failures: their error is returned as is, so that the poller stops before committing them.
+func NewHandler(next poller.TaskHandler, store Store, onFailure OnFailure) poller.TaskHandler {
+	return poller.TaskHandlerFunc(func(ctx context.Context, task api.TaskItem) error {
+		cause := next.HandleTask(ctx, task)
+		if cause == nil || interrupted(ctx, cause) {
+			return cause
+		}
+
+		var event *api.CannotExecuteTaskEvent
+		if onFailure != nil {
+			var err error
+			if event, err = onFailure(ctx, task, cause); err != nil {
+				cause = errors.Join(cause, fmt.Errorf("failed to report failure: %w", err))
+			}
+		}
+
+		if _, err := Record(ctx, store, task, cause, event); err != nil {
+			return err
+		}
+
+		return fmt.Errorf("%w: %w", poller.ErrTaskFailed, cause)
+	})
+}
+
+// ReplayResult lists tasks by the outcome of a replay
+type ReplayResult struct {
+	Succeeded []uuid.UUID
+	Failed    []uuid.UUID
+}
+
+// Replay hands entries to the handler again, all entries if no task IDs are given.
+// Entries of succeeded tasks are deleted, failures are recorded as new attempts.
+// An error is returned only if the store fails or ctx is done.
+func Replay(ctx context.Context, store Store, handler poller.TaskHandler, taskItemIDs ...uuid.UUID) (ReplayResult, error) {
+	entries, err := entriesOf(ctx, store, taskItemIDs)
+	if err != nil {
+		return ReplayResult{}, err
+	}
+
+	var result ReplayResult
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		if cause := handler.HandleTask(ctx, entry.Task); cause != nil {
+			if interrupted(ctx, cause) {
+				return result, cause
+			}
+			if _, err := Record(ctx, store, entry.Task, cause, nil); err != nil {
+				return result, err
+			}
+			result.Failed = append(result.Failed, entry.Task.ID)
+			continue
+		}
+
+		if err := store.Delete(ctx, entry.Task.ID); err != nil {
+			return result, err
+		}
+		result.Succeeded = append(result.Succeeded, entry.Task.ID)
+	}
+
+	return result, nil
+}
+
+// interrupted returns true if the task failed because ctx is done rather than because of the task
+func interrupted(ctx context.Context, cause error) bool {
+	return ctx.Err() != nil || errors.Is(cause, context.Canceled) || errors.Is(cause, context.DeadlineExceeded)
+}
+
+func entriesOf(ctx context.Context, store Store, taskItemIDs []uuid.UUID) ([]Entry, error) {
+	if len(taskItemIDs) == 0 {
+		return store.List(ctx)
+	}
+
+	entries := make([]Entry, 0, len(taskItemIDs))
+	for _, id := range taskItemIDs {
+		entry, err := store.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,50 @@
+// Package eventid derives event IDs that are unique and stable across retries, so that events can be republished idempotently.
+//
+// The canonical formats are
+//
+//	tx:<chain>:<txID>:<logIndex>:<eventType>
+//	task:<taskItemID>:<attempt>
+//
+// where logIndex and attempt are decimal numbers, taskItemID is the lowercase hyphenated UUID,
+// and chain and txID are taken verbatim except that '%' and ':' are escaped as "%25" and "%3A".
+// The escaping keeps the fields apart, so that distinct inputs never derive the same ID.
+// Inputs are not normalized otherwise, e.g. the same transaction hash in different letter cases derives different IDs,
+// so callers must consistently use one representation per chain.
+package eventid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+const (
+	txPrefix   = "tx"
+	taskPrefix = "task"
+	separator  = ":"
+)
+
+var escaper = strings.NewReplacer("%", "%25", separator, "%3A")
+
+// FromTx derives the ID of an event emitted by the transaction, e.g. a CALL event of a contract call
+func FromTx(chain, txID string, logIndex uint64, eventType api.EventType) string {
+	return strings.Join([]string{
+		txPrefix,
+		escaper.Replace(chain),
+		escaper.Replace(txID),
+		fmt.Sprint(logIndex),
+		escaper.Replace(string(eventType)),
+	}, separator)
+}
+
+// FromTask derives the ID of an event reporting an attempt of the task, e.g. a CANNOT_EXECUTE_TASK event
+func FromTask(taskItemID uuid.UUID, attempt uint64) string {
+	return strings.Join([]string{
+		taskPrefix,
+		taskItemID.String(),
+		fmt.Sprint(attempt),
+	}, separator)
+}
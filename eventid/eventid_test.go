@@ -0,0 +1,77 @@
+package eventid_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/eventid"
+)
+
+func TestFromTx(t *testing.T) {
+	assert.Equal(t, "tx:ethereum:0xabc:3:CALL", eventid.FromTx("ethereum", "0xabc", 3, api.EventTypeCall))
+	assert.Equal(t, "tx:cosmos%3Ahub:a%25b%3Ac:0:CALL", eventid.FromTx("cosmos:hub", "a%b:c", 0, api.EventTypeCall))
+	assert.Equal(t, eventid.FromTx("ethereum", "0xabc", 3, api.EventTypeCall), eventid.FromTx("ethereum", "0xabc", 3, api.EventTypeCall))
+}
+
+func TestFromTask(t *testing.T) {
+	taskItemID := uuid.MustParse("2f6b5ba1-7c2b-4a2a-9f1e-0c7e3c4a7b10")
+	assert.Equal(t, "task:2f6b5ba1-7c2b-4a2a-9f1e-0c7e3c4a7b10:1", eventid.FromTask(taskItemID, 1))
+}
+
+func TestCollisions(t *testing.T) {
+	taskItemID := uuid.New()
+
+	// inputs that collide under naive concatenation
+	ids := []string{
+		eventid.FromTx("a:b", "c", 1, api.EventTypeCall),
+		eventid.FromTx("a", "b:c", 1, api.EventTypeCall),
+		eventid.FromTx("a%3Ab", "c", 1, api.EventTypeCall),
+		eventid.FromTx("a", "b%3Ac", 1, api.EventTypeCall),
+		eventid.FromTx("a", "b", 11, api.EventTypeCall),
+		eventid.FromTx("a", "b1", 1, api.EventTypeCall),
+		eventid.FromTx("a", "b", 1, api.EventTypeGasRefunded),
+		eventid.FromTx("task", taskItemID.String(), 1, api.EventTypeCall),
+		eventid.FromTask(taskItemID, 1),
+		eventid.FromTask(taskItemID, 11),
+	}
+	assertUnique(t, ids)
+
+	t.Run("random inputs", func(t *testing.T) {
+		r := rand.New(rand.NewSource(1))
+		alphabet := []rune("ab:%3A1")
+		str := func() string {
+			s := make([]rune, r.Intn(4))
+			for i := range s {
+				s[i] = alphabet[r.Intn(len(alphabet))]
+			}
+			return string(s)
+		}
+
+		seen := make(map[string]string)
+		for range 10_000 {
+			input := [3]any{str(), str(), uint64(r.Intn(12))}
+			key := fmt.Sprintf("%q", input)
+			id := eventid.FromTx(input[0].(string), input[1].(string), input[2].(uint64), api.EventTypeCall)
+
+			if other, ok := seen[id]; ok {
+				assert.Equal(t, other, key, "different inputs derived the same ID %s", id)
+			}
+			seen[id] = key
+		}
+	})
+}
+
+func assertUnique(t *testing.T, ids []string) {
+	t.Helper()
+
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		assert.False(t, seen[id], "duplicate ID %s", id)
+		seen[id] = true
+	}
+}
@@ -0,0 +1,33 @@
+package atomicfile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/atomicfile"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+)
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+
+	require.NoError(t, atomicfile.Write(path, []byte("old")))
+	require.NoError(t, atomicfile.Write(path, []byte("new")))
+	assert.Equal(t, "new", string(funcs.Must(os.ReadFile(path))))
+
+	files := funcs.Must(os.ReadDir(dir))
+	assert.Len(t, files, 1, "no temporary files must be left behind")
+
+	t.Run("should remove the temporary file if the rename fails", func(t *testing.T) {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "taken"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "taken", "child"), nil, 0o600))
+
+		assert.Error(t, atomicfile.Write(filepath.Join(dir, "taken"), []byte("new")))
+		assert.Len(t, funcs.Must(os.ReadDir(dir)), 2, "no temporary files must be left behind")
+	})
+}
@@ -19,3 +19,14 @@ func MustNoErr(err error) {
 		panic(fmt.Errorf("call should not have failed: %w", err))
 	}
 }
+
+// FirstNonNil returns the first non-nil pointer, or nil if all are nil
+func FirstNonNil[T any](values ...*T) *T {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,297 @@
+// Package releasenotes generates a markdown skeleton of a release note from differences between two schema versions.
+// The skeleton follows the format of schema/releases/*.md. Sections that need a human, e.g. Background, are left with TODOs.
+package releasenotes
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/schemadiff"
+)
+
+const (
+	eventComponent     = "Event"
+	eventTypeComponent = "EventType"
+	taskComponent      = "Task"
+	taskItemComponent  = "TaskItem"
+	taskTypeComponent  = "TaskType"
+)
+
+// Notes is the content of a release note
+type Notes struct {
+	Date             string
+	Breaking         []string
+	NewEndpoints     []string
+	UpdatedEndpoints []string
+	NewEvents        []string
+	UpdatedEvents    []string
+	NewTasks         []string
+	UpdatedTasks     []string
+	Deprecations     []string
+	Miscellaneous    []string
+}
+
+// Build derives release notes from changes between base and revision
+func Build(date string, base, revision *openapi3.T) Notes {
+	notes := Notes{Date: date}
+	resolver := newResolver(base, revision)
+
+	updatedEvents := make(map[string][]string)
+	updatedTasks := make(map[string][]string)
+
+	for _, change := range schemadiff.Compare(base, revision) {
+		if change.Breaking {
+			notes.Breaking = append(notes.Breaking, describeBreaking(change))
+		}
+
+		component := change.Component()
+
+		switch {
+		case change.Kind == schemadiff.KindDeprecated:
+			notes.Deprecations = append(notes.Deprecations, fmt.Sprintf("`%s`", subjectPath(change)))
+		case change.Kind == schemadiff.KindOperationAdded:
+			notes.NewEndpoints = append(notes.NewEndpoints, change.Subject)
+		case strings.HasPrefix(change.Location, "paths/"):
+			notes.UpdatedEndpoints = append(notes.UpdatedEndpoints, fmt.Sprintf("`%s`: %s", operationOf(change), describe(change)))
+		case change.Kind == schemadiff.KindOperationRemoved:
+			notes.UpdatedEndpoints = append(notes.UpdatedEndpoints, fmt.Sprintf("`%s` was removed", change.Subject))
+		case component == eventTypeComponent && change.Kind == schemadiff.KindEnumValueAdded:
+			notes.NewEvents = append(notes.NewEvents, fmt.Sprintf("`%s`. TODO: describe the event and who should publish it.", change.Subject))
+		case component == taskTypeComponent && change.Kind == schemadiff.KindEnumValueAdded:
+			notes.NewTasks = append(notes.NewTasks, fmt.Sprintf("`%s`. TODO: describe the task and how relayers should handle it.", change.Subject))
+		case component == eventComponent || component == taskComponent || component == eventTypeComponent || component == taskTypeComponent:
+			// variants and discriminator values are covered by new events and tasks
+			if !change.Breaking {
+				continue
+			}
+			notes.Miscellaneous = append(notes.Miscellaneous, fmt.Sprintf("`%s`: %s", component, describe(change)))
+		case change.Kind == schemadiff.KindComponentAdded || change.Kind == schemadiff.KindComponentRenamed || change.Kind == schemadiff.KindComponentRemoved:
+			notes.Miscellaneous = append(notes.Miscellaneous, describeComponent(change))
+		default:
+			if eventType, ok := resolver.eventOf(component); ok {
+				updatedEvents[eventType] = append(updatedEvents[eventType], describeField(change, resolver.root(component)))
+			} else if task, ok := resolver.taskOf(component); ok {
+				updatedTasks[task] = append(updatedTasks[task], describeField(change, resolver.root(component)))
+			} else {
+				notes.Miscellaneous = append(notes.Miscellaneous, fmt.Sprintf("`%s`: %s", component, describe(change)))
+			}
+		}
+	}
+
+	notes.UpdatedEvents = group(updatedEvents)
+	notes.UpdatedTasks = group(updatedTasks)
+
+	return notes
+}
+
+// Render writes notes as markdown
+func (n Notes) Render(w io.Writer) error {
+	return notesTemplate.Execute(w, n)
+}
+
+func group(changes map[string][]string) []string {
+	var result []string
+	for _, name := range slices.Sorted(maps.Keys(changes)) {
+		result = append(result, fmt.Sprintf("`%s`: %s.", name, strings.Join(changes[name], "; ")))
+	}
+
+	return result
+}
+
+func describeBreaking(change schemadiff.Change) string {
+	if component := change.Component(); component != "" {
+		return fmt.Sprintf("`%s`: %s", component, describe(change))
+	}
+
+	return fmt.Sprintf("`%s`: %s", change.Location, describe(change))
+}
+
+func describeComponent(change schemadiff.Change) string {
+	switch change.Kind {
+	case schemadiff.KindComponentRenamed:
+		return fmt.Sprintf("`%s` -> `%s`", change.Subject, strings.TrimPrefix(change.Detail, "renamed to "))
+	case schemadiff.KindComponentRemoved:
+		return fmt.Sprintf("`%s` was removed", change.Subject)
+	default:
+		return fmt.Sprintf("`%s` was added", change.Subject)
+	}
+}
+
+// operationSubKeys are keys of an operation that locations of changes within the operation continue with
+var operationSubKeys = []string{"/parameters", "/responses"}
+
+// operationOf returns the operation a change under paths/ belongs to, e.g. "GET /chains/{chain}/tasks".
+// The operation key contains slashes of its path, so it's cut at the first sub-key of the operation instead of at a slash.
+func operationOf(change schemadiff.Change) string {
+	operation := strings.TrimPrefix(change.Location, "paths/")
+
+	for i := range len(operation) {
+		if operation[i] != '/' {
+			continue
+		}
+
+		for _, key := range operationSubKeys {
+			// the sub-key must be a whole segment, not a prefix of a path segment like /parametersX
+			if rest, ok := strings.CutPrefix(operation[i:], key); ok && (rest == "" || rest[0] == '/') {
+				return operation[:i]
+			}
+		}
+	}
+
+	return operation
+}
+
+func describeField(change schemadiff.Change, root string) string {
+	path := subjectPath(change)
+	if root != "" && path != root {
+		path = strings.TrimPrefix(strings.TrimPrefix(path, root), ".")
+	}
+
+	return describeAt(change, "`"+path+"`")
+}
+
+func describe(change schemadiff.Change) string {
+	return describeAt(change, "`"+change.Subject+"`")
+}
+
+func describeAt(change schemadiff.Change, subject string) string {
+	var s string
+	switch change.Kind {
+	case schemadiff.KindPropertyAdded:
+		s = "new property " + subject
+	case schemadiff.KindPropertyRemoved:
+		s = "removed property " + subject
+	case schemadiff.KindRequiredAdded:
+		s = "new required property " + subject
+		if change.Detail == "property became required" {
+			s = "property " + subject + " became required"
+		}
+	case schemadiff.KindRequiredRemoved:
+		s = "property " + subject + " became optional"
+	case schemadiff.KindEnumValueAdded:
+		s = "new value " + subject
+	case schemadiff.KindEnumValueRemoved:
+		s = "removed value " + subject
+	case schemadiff.KindParameterAdded:
+		s = "new parameter " + subject
+	case schemadiff.KindParameterRemoved:
+		s = "removed parameter " + subject
+	case schemadiff.KindParameterRequired:
+		s = "required parameter " + subject
+	case schemadiff.KindResponseAdded:
+		s = "new response " + subject
+	case schemadiff.KindResponseRemoved:
+		s = "removed response " + subject
+	default:
+		s = strings.ReplaceAll(string(change.Kind), "-", " ") + " " + subject
+	}
+
+	if change.Detail != "" && change.Kind != schemadiff.KindRequiredAdded && change.Kind != schemadiff.KindRequiredRemoved {
+		s += " (" + change.Detail + ")"
+	}
+
+	return s
+}
+
+// compositionPattern matches steps into inline variants, which don't show up in JSON paths
+var compositionPattern = regexp.MustCompile(`/(allOf|oneOf|anyOf)/\d+`)
+
+// subjectPath renders the location of a change as a path of properties, e.g. "CallEvent.meta.sourceContext"
+func subjectPath(change schemadiff.Change) string {
+	location := strings.TrimPrefix(change.Location, "components/schemas/")
+	location = compositionPattern.ReplaceAllString(location, "")
+	location = strings.ReplaceAll(location, "/properties", "")
+	location = strings.TrimSuffix(location, "/enum")
+	location = strings.ReplaceAll(location, "/", ".")
+
+	if !strings.HasSuffix(location, "."+change.Subject) && location != change.Subject {
+		location += "." + change.Subject
+	}
+
+	return location
+}
+
+var notesTemplate = template.Must(template.New("notes").Parse(`# Changes released on {{.Date}}
+
+|                | **Owner**     |
+|----------------|---------------|
+| **Created By** | TODO          |
+
+| **Network**          | **Tag/Commit** | **Deployment Status** | **Date** |
+|:---------------------|----------------|-----------------------|----------|
+| ` + "`devnet-amplifier`" + `   | -              | -                     | TBD      |
+| ` + "`stagenet`" + `           | -              | -                     | TBD      |
+| ` + "`testnet`" + `            | -              | -                     | TBD      |
+| ` + "`mainnet`" + `            | -              | -                     | TBD      |
+
+## Background
+
+TODO: describe the motivation of the release.
+
+## Breaking and mandatory changes
+{{if .Breaking}}
+The following changes are breaking:
+{{range .Breaking}}
+- {{.}}{{end}}
+{{else}}
+All changes in this set are non-breaking. All integrations should continue to function without interruptions.
+{{end}}
+## Overview
+{{- if .NewEndpoints}}
+
+### New endpoints
+
+` + "```" + `
+{{range .NewEndpoints}}{{.}}
+{{end}}` + "```" + `
+{{- end}}
+{{- if .UpdatedEndpoints}}
+
+### Updated endpoints
+{{range .UpdatedEndpoints}}
+- {{.}}{{end}}
+{{- end}}
+{{- if .NewEvents}}
+
+### New Events
+{{range .NewEvents}}
+- {{.}}{{end}}
+{{- end}}
+{{- if .UpdatedEvents}}
+
+### Updated Events
+{{range .UpdatedEvents}}
+- {{.}}{{end}}
+{{- end}}
+{{- if .NewTasks}}
+
+### New Tasks
+{{range .NewTasks}}
+- {{.}}{{end}}
+{{- end}}
+{{- if .UpdatedTasks}}
+
+### Updated Tasks
+{{range .UpdatedTasks}}
+- {{.}}{{end}}
+{{- end}}
+{{- if .Deprecations}}
+
+### Deprecations
+{{range .Deprecations}}
+- {{.}}{{end}}
+{{- end}}
+{{- if .Miscellaneous}}
+
+### Miscellaneous
+{{range .Miscellaneous}}
+- {{.}}{{end}}
+{{- end}}
+`))
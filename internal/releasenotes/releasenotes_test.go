@@ -0,0 +1,72 @@
+package releasenotes_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/releasenotes"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/schemadiff"
+)
+
+// load reads a fixture schema from testdata
+func load(t *testing.T, name string) *openapi3.T {
+	t.Helper()
+
+	return funcs.Must(schemadiff.Load(funcs.Must(os.ReadFile(filepath.Join("testdata", name)))))
+}
+
+// TestBuild diffs fixtures rather than the live schema, so that it doesn't break as the schema grows
+func TestBuild(t *testing.T) {
+	base := load(t, "base.yaml")
+	revision := load(t, "revision.yaml")
+
+	notes := releasenotes.Build("2025-03-01", base, revision)
+
+	assert.Empty(t, notes.Breaking)
+	assert.Equal(t, []string{"GET /chains"}, notes.NewEndpoints)
+	assert.Equal(t, []string{
+		"`GET /chains/{chain}/tasks`: new parameter `query:priority`",
+		"`GET /chains/{chain}/tasks/{taskItemID}`: new response `410`",
+	}, notes.UpdatedEndpoints)
+	require.Len(t, notes.NewEvents, 1)
+	assert.Contains(t, notes.NewEvents[0], "`NEW_EVENT`")
+	require.Len(t, notes.NewTasks, 1)
+	assert.Contains(t, notes.NewTasks[0], "`NEW_TASK`")
+	assert.Equal(t, []string{"`CALL`: new property `memo`; new property `CallEventMetadata.relayerID`."}, notes.UpdatedEvents)
+	assert.Equal(t, []string{"`CallEvent.destinationChain`"}, notes.Deprecations)
+
+	var buf bytes.Buffer
+	require.NoError(t, notes.Render(&buf))
+	markdown := buf.String()
+
+	for _, section := range []string{
+		"# Changes released on 2025-03-01",
+		"## Background",
+		"All changes in this set are non-breaking.",
+		"### New endpoints\n\n```\nGET /chains\n```",
+		"### Updated endpoints\n\n- `GET /chains/{chain}/tasks`: new parameter `query:priority`",
+		"### New Events",
+		"### Updated Events",
+		"### New Tasks",
+		"### Deprecations",
+	} {
+		assert.Contains(t, markdown, section)
+	}
+	assert.NotContains(t, markdown, "### Updated Tasks")
+}
+
+func TestBuild_Breaking(t *testing.T) {
+	notes := releasenotes.Build("2025-03-01", load(t, "base.yaml"), load(t, "breaking.yaml"))
+	assert.Contains(t, notes.Breaking, "`EventType`: removed value `GAS_CREDIT`")
+
+	var buf bytes.Buffer
+	require.NoError(t, notes.Render(&buf))
+	assert.Contains(t, buf.String(), "The following changes are breaking:")
+}
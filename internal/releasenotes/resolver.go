@@ -0,0 +1,92 @@
+package releasenotes
+
+import (
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// resolver attributes component schemas to events and tasks, so that field changes can be listed under the event or task they affect.
+// A component belongs to an event or a task if it's a variant of Event or Task, or if its name starts with the name of such a variant,
+// e.g. CallEventMetadata belongs to CallEvent.
+type resolver struct {
+	// events maps components of event variants to event types
+	events map[string]string
+	// tasks maps components of task variants to themselves, tasks don't have a discriminator mapping
+	tasks map[string]string
+}
+
+func newResolver(docs ...*openapi3.T) resolver {
+	r := resolver{
+		events: make(map[string]string),
+		tasks:  make(map[string]string),
+	}
+
+	for _, doc := range docs {
+		if doc.Components == nil {
+			continue
+		}
+
+		if event, ok := doc.Components.Schemas[eventComponent]; ok && event.Value != nil && event.Value.Discriminator != nil {
+			for eventType, ref := range event.Value.Discriminator.Mapping {
+				r.events[refName(ref)] = eventType
+			}
+		}
+
+		if task, ok := doc.Components.Schemas[taskComponent]; ok && task.Value != nil {
+			for _, variant := range task.Value.OneOf {
+				name := refName(variant.Ref)
+				r.tasks[name] = name
+			}
+		}
+
+		// the task envelope carries metadata of all tasks
+		r.tasks[taskItemComponent] = taskItemComponent
+	}
+
+	return r
+}
+
+func (r resolver) eventOf(component string) (string, bool) {
+	if root := longestPrefix(r.events, component); root != "" {
+		return r.events[root], true
+	}
+
+	return "", false
+}
+
+func (r resolver) taskOf(component string) (string, bool) {
+	if root := longestPrefix(r.tasks, component); root != "" {
+		return r.tasks[root], true
+	}
+
+	return "", false
+}
+
+// root returns the event or task variant the component belongs to, if the component is the variant itself
+func (r resolver) root(component string) string {
+	if _, ok := r.events[component]; ok {
+		return component
+	}
+
+	if _, ok := r.tasks[component]; ok {
+		return component
+	}
+
+	return ""
+}
+
+func longestPrefix(roots map[string]string, component string) string {
+	var result string
+	for root := range roots {
+		if strings.HasPrefix(component, root) && len(root) > len(result) {
+			result = root
+		}
+	}
+
+	return result
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
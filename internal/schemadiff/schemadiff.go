@@ -0,0 +1,546 @@
+// Package schemadiff compares two versions of the OpenAPI schema and classifies differences as breaking or non-breaking.
+// Changes are classified for clients: a change is breaking if a client generated from the base schema,
+// or an integration built against it, may stop compiling or working against a server implementing the revised schema.
+// Additions like new operations and optional parameters are non-breaking for clients,
+// but they change the generated ServerInterface, so server implementations must be updated for any change.
+package schemadiff
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Kind is a kind of Change
+type Kind string
+
+// Kinds of changes
+const (
+	KindOperationAdded     Kind = "operation-added"
+	KindOperationRemoved   Kind = "operation-removed"
+	KindParameterAdded     Kind = "parameter-added"
+	KindParameterRemoved   Kind = "parameter-removed"
+	KindParameterRequired  Kind = "parameter-required"
+	KindResponseAdded      Kind = "response-added"
+	KindResponseRemoved    Kind = "response-removed"
+	KindRequestBodyChanged Kind = "request-body-changed"
+	KindComponentAdded     Kind = "component-added"
+	KindComponentRemoved   Kind = "component-removed"
+	KindComponentRenamed   Kind = "component-renamed"
+	KindPropertyAdded      Kind = "property-added"
+	KindPropertyRemoved    Kind = "property-removed"
+	KindRequiredAdded      Kind = "required-added"
+	KindRequiredRemoved    Kind = "required-removed"
+	KindTypeChanged        Kind = "type-changed"
+	KindFormatChanged      Kind = "format-changed"
+	KindPatternChanged     Kind = "pattern-changed"
+	KindPatternRemoved     Kind = "pattern-removed"
+	KindEnumValueAdded     Kind = "enum-value-added"
+	KindEnumValueRemoved   Kind = "enum-value-removed"
+	KindRefChanged         Kind = "ref-changed"
+	KindVariantAdded       Kind = "variant-added"
+	KindVariantRemoved     Kind = "variant-removed"
+	KindDeprecated         Kind = "deprecated"
+)
+
+// breakingKinds are kinds of changes that are always breaking
+var breakingKinds = map[Kind]bool{
+	KindOperationRemoved:   true,
+	KindParameterRemoved:   true,
+	KindParameterRequired:  true,
+	KindResponseRemoved:    true,
+	KindRequestBodyChanged: true,
+	KindComponentRemoved:   true,
+	KindComponentRenamed:   true,
+	KindPropertyRemoved:    true,
+	KindRequiredAdded:      true,
+	KindRequiredRemoved:    true,
+	KindTypeChanged:        true,
+	KindFormatChanged:      true,
+	KindPatternChanged:     true,
+	KindEnumValueRemoved:   true,
+	KindRefChanged:         true,
+	KindVariantRemoved:     true,
+}
+
+// Change is a single difference between two schema versions
+type Change struct {
+	Kind Kind `json:"kind"`
+	// Breaking is true if the change breaks clients built against the base schema
+	Breaking bool `json:"breaking"`
+	// Location is a slash-separated path to the changed element, e.g. "components/schemas/CallEvent/properties/meta"
+	// or "paths/GET /chains/{chain}/tasks/parameters/after"
+	Location string `json:"location"`
+	// Subject is the name of the added, removed or changed item, e.g. an operation, a component, a property or an enum value
+	Subject string `json:"subject"`
+	// Detail describes the change in a human-readable form, e.g. old and new values
+	Detail string `json:"detail,omitempty"`
+}
+
+// String formats the change for humans
+func (c Change) String() string {
+	severity := "non-breaking"
+	if c.Breaking {
+		severity = "BREAKING"
+	}
+
+	s := fmt.Sprintf("%-12s %-20s %s: %s", severity, c.Kind, c.Location, c.Subject)
+	if c.Detail != "" {
+		s += " (" + c.Detail + ")"
+	}
+
+	return s
+}
+
+// Component returns the name of the component schema the change belongs to, or an empty string if it doesn't belong to one
+func (c Change) Component() string {
+	rest, ok := strings.CutPrefix(c.Location, "components/schemas/")
+	if !ok {
+		return ""
+	}
+
+	name, _, _ := strings.Cut(rest, "/")
+	return name
+}
+
+// HasBreaking returns true if any of the changes is breaking
+func HasBreaking(changes []Change) bool {
+	return slices.ContainsFunc(changes, func(c Change) bool { return c.Breaking })
+}
+
+// Load parses and validates a schema
+func Load(data []byte) (*openapi3.T, error) {
+	loader := openapi3.NewLoader()
+
+	doc, err := loader.LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schema: %w", err)
+	}
+
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	return doc, nil
+}
+
+// Compare returns changes from base to revision, sorted by location
+func Compare(base, revision *openapi3.T) []Change {
+	d := &differ{}
+	d.comparePaths(base.Paths, revision.Paths)
+	d.compareComponents(base.Components, revision.Components)
+
+	slices.SortStableFunc(d.changes, func(a, b Change) int {
+		return strings.Compare(a.Location+"/"+a.Subject, b.Location+"/"+b.Subject)
+	})
+
+	return d.changes
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(kind Kind, location, subject, detail string) {
+	d.changes = append(d.changes, Change{
+		Kind:     kind,
+		Breaking: breakingKinds[kind],
+		Location: location,
+		Subject:  subject,
+		Detail:   detail,
+	})
+}
+
+func (d *differ) comparePaths(base, revision *openapi3.Paths) {
+	baseOps := operations(base)
+	revisionOps := operations(revision)
+
+	for _, key := range sortedKeys(baseOps) {
+		revisionOp, ok := revisionOps[key]
+		if !ok {
+			d.add(KindOperationRemoved, "paths", key, "")
+			continue
+		}
+
+		d.compareOperation("paths/"+key, baseOps[key], revisionOp)
+	}
+
+	for _, key := range sortedKeys(revisionOps) {
+		if _, ok := baseOps[key]; !ok {
+			d.add(KindOperationAdded, "paths", key, revisionOps[key].OperationID)
+		}
+	}
+}
+
+// operations returns operations keyed by "METHOD /path"
+func operations(paths *openapi3.Paths) map[string]*openapi3.Operation {
+	result := make(map[string]*openapi3.Operation)
+	if paths == nil {
+		return result
+	}
+
+	for path, item := range paths.Map() {
+		for method, op := range item.Operations() {
+			result[method+" "+path] = op
+		}
+	}
+
+	return result
+}
+
+func (d *differ) compareOperation(location string, base, revision *openapi3.Operation) {
+	if revision.Deprecated && !base.Deprecated {
+		d.add(KindDeprecated, location, revision.OperationID, "")
+	}
+
+	d.compareParameters(location+"/parameters", base.Parameters, revision.Parameters)
+
+	if ref, revisionRef := requestBodyRef(base), requestBodyRef(revision); ref != revisionRef {
+		d.add(KindRequestBodyChanged, location, "requestBody", fmt.Sprintf("%s -> %s", ref, revisionRef))
+	}
+
+	baseResponses := responseCodes(base)
+	revisionResponses := responseCodes(revision)
+	for _, code := range sortedKeys(baseResponses) {
+		if _, ok := revisionResponses[code]; !ok {
+			d.add(KindResponseRemoved, location+"/responses", code, "")
+		}
+	}
+	for _, code := range sortedKeys(revisionResponses) {
+		if _, ok := baseResponses[code]; !ok {
+			d.add(KindResponseAdded, location+"/responses", code, "")
+		}
+	}
+}
+
+func (d *differ) compareParameters(location string, base, revision openapi3.Parameters) {
+	baseParams := parameters(base)
+	revisionParams := parameters(revision)
+
+	for _, key := range sortedKeys(baseParams) {
+		revisionParam, ok := revisionParams[key]
+		if !ok {
+			d.add(KindParameterRemoved, location, key, "")
+			continue
+		}
+
+		baseParam := baseParams[key]
+		if revisionParam.Required && !baseParam.Required {
+			d.add(KindParameterRequired, location, key, "")
+		}
+		if revisionParam.Deprecated && !baseParam.Deprecated {
+			d.add(KindDeprecated, location, key, "")
+		}
+		d.compareSchemaRefs(location+"/"+key+"/schema", baseParam.Schema, revisionParam.Schema)
+	}
+
+	for _, key := range sortedKeys(revisionParams) {
+		if _, ok := baseParams[key]; ok {
+			continue
+		}
+
+		kind := KindParameterAdded
+		if revisionParams[key].Required {
+			kind = KindParameterRequired
+		}
+		d.add(kind, location, key, "")
+	}
+}
+
+// parameters returns parameters keyed by "in:name"
+func parameters(params openapi3.Parameters) map[string]*openapi3.Parameter {
+	result := make(map[string]*openapi3.Parameter, len(params))
+	for _, ref := range params {
+		if ref == nil || ref.Value == nil {
+			continue
+		}
+		result[ref.Value.In+":"+ref.Value.Name] = ref.Value
+	}
+
+	return result
+}
+
+func requestBodyRef(op *openapi3.Operation) string {
+	if op.RequestBody == nil || op.RequestBody.Value == nil {
+		return ""
+	}
+
+	var refs []string
+	for _, contentType := range sortedKeys(op.RequestBody.Value.Content) {
+		media := op.RequestBody.Value.Content[contentType]
+		ref := contentType
+		if media.Schema != nil {
+			ref += ":" + media.Schema.Ref
+		}
+		refs = append(refs, ref)
+	}
+
+	return strings.Join(refs, ",")
+}
+
+func responseCodes(op *openapi3.Operation) map[string]struct{} {
+	result := make(map[string]struct{})
+	if op.Responses == nil {
+		return result
+	}
+
+	for code := range op.Responses.Map() {
+		result[code] = struct{}{}
+	}
+
+	return result
+}
+
+func (d *differ) compareComponents(base, revision *openapi3.Components) {
+	var baseSchemas, revisionSchemas openapi3.Schemas
+	if base != nil {
+		baseSchemas = base.Schemas
+	}
+	if revision != nil {
+		revisionSchemas = revision.Schemas
+	}
+
+	var removed, added []string
+	for _, name := range sortedKeys(baseSchemas) {
+		revisionSchema, ok := revisionSchemas[name]
+		if !ok {
+			removed = append(removed, name)
+			continue
+		}
+
+		d.compareSchemaRefs("components/schemas/"+name, baseSchemas[name], revisionSchema)
+	}
+	for _, name := range sortedKeys(revisionSchemas) {
+		if _, ok := baseSchemas[name]; !ok {
+			added = append(added, name)
+		}
+	}
+
+	// a removed component with the same shape as an added one is reported as renamed,
+	// shapes don't change for integrations, but names of generated types do
+	renamed := make(map[string]bool)
+	for _, oldName := range removed {
+		i := slices.IndexFunc(added, func(newName string) bool {
+			return !renamed[newName] && sameShape(baseSchemas[oldName], revisionSchemas[newName])
+		})
+		if i < 0 {
+			d.add(KindComponentRemoved, "components/schemas", oldName, "")
+			continue
+		}
+
+		renamed[added[i]] = true
+		d.add(KindComponentRenamed, "components/schemas", oldName, "renamed to "+added[i])
+	}
+	for _, name := range added {
+		if !renamed[name] {
+			d.add(KindComponentAdded, "components/schemas", name, "")
+		}
+	}
+}
+
+func sameShape(a, b *openapi3.SchemaRef) bool {
+	if a == nil || b == nil || a.Value == nil || b.Value == nil {
+		return false
+	}
+
+	aJSON, aErr := a.Value.MarshalJSON()
+	bJSON, bErr := b.Value.MarshalJSON()
+
+	return aErr == nil && bErr == nil && string(aJSON) == string(bJSON)
+}
+
+// compareSchemaRefs compares schemas at the same location. Referenced schemas are compared by name only,
+// because every component is compared on its own, unless an inline schema is replaced by a reference or the other way around.
+func (d *differ) compareSchemaRefs(location string, base, revision *openapi3.SchemaRef) {
+	if base == nil || revision == nil || base.Value == nil || revision.Value == nil {
+		if (base == nil) != (revision == nil) {
+			d.add(KindTypeChanged, location, "schema", "")
+		}
+		return
+	}
+
+	isComponent := strings.Count(location, "/") == 2 && strings.HasPrefix(location, "components/schemas/")
+	if !isComponent && (base.Ref != "" || revision.Ref != "") {
+		switch {
+		case base.Ref == revision.Ref:
+		case base.Ref == "" || revision.Ref == "":
+			// an inline schema moved into a component, or the other way around, only changes if its value does
+			d.compareSchemas(location, base.Value, revision.Value)
+		default:
+			d.add(KindRefChanged, location, refName(revision.Ref), fmt.Sprintf("%s -> %s", refName(base.Ref), refName(revision.Ref)))
+		}
+		return
+	}
+
+	d.compareSchemas(location, base.Value, revision.Value)
+}
+
+func (d *differ) compareSchemas(location string, base, revision *openapi3.Schema) {
+	subject := location[strings.LastIndex(location, "/")+1:]
+
+	if !slices.Equal(schemaTypes(base), schemaTypes(revision)) {
+		d.add(KindTypeChanged, location, subject, fmt.Sprintf("%v -> %v", schemaTypes(base), schemaTypes(revision)))
+	}
+
+	if base.Format != revision.Format {
+		d.add(KindFormatChanged, location, subject, fmt.Sprintf("%q -> %q", base.Format, revision.Format))
+	}
+
+	switch {
+	case base.Pattern == revision.Pattern:
+	case revision.Pattern == "":
+		d.add(KindPatternRemoved, location, subject, base.Pattern)
+	default:
+		d.add(KindPatternChanged, location, subject, fmt.Sprintf("%q -> %q", base.Pattern, revision.Pattern))
+	}
+
+	if revision.Deprecated && !base.Deprecated {
+		d.add(KindDeprecated, location, subject, "")
+	}
+
+	d.compareEnums(location+"/enum", base.Enum, revision.Enum)
+	d.compareVariants(location+"/oneOf", base.OneOf, revision.OneOf)
+	d.compareVariants(location+"/anyOf", base.AnyOf, revision.AnyOf)
+	d.compareVariants(location+"/allOf", base.AllOf, revision.AllOf)
+	d.compareProperties(location, base, revision)
+
+	if base.Items != nil || revision.Items != nil {
+		d.compareSchemaRefs(location+"/items", base.Items, revision.Items)
+	}
+
+	if base.AdditionalProperties.Schema != nil || revision.AdditionalProperties.Schema != nil {
+		d.compareSchemaRefs(location+"/additionalProperties", base.AdditionalProperties.Schema, revision.AdditionalProperties.Schema)
+	}
+}
+
+func (d *differ) compareEnums(location string, base, revision []any) {
+	baseValues := enumValues(base)
+	revisionValues := enumValues(revision)
+
+	for _, value := range sortedKeys(baseValues) {
+		if _, ok := revisionValues[value]; !ok {
+			d.add(KindEnumValueRemoved, location, value, "")
+		}
+	}
+
+	// a new enum on a schema that didn't have one tightens it
+	if len(base) == 0 && len(revision) > 0 {
+		d.add(KindTypeChanged, location, "enum", "enum added")
+		return
+	}
+
+	for _, value := range sortedKeys(revisionValues) {
+		if _, ok := baseValues[value]; !ok {
+			d.add(KindEnumValueAdded, location, value, "")
+		}
+	}
+}
+
+func enumValues(values []any) map[string]struct{} {
+	result := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		result[fmt.Sprint(value)] = struct{}{}
+	}
+
+	return result
+}
+
+func (d *differ) compareVariants(location string, base, revision openapi3.SchemaRefs) {
+	baseRefs := variantRefs(base)
+	revisionRefs := variantRefs(revision)
+
+	for _, ref := range sortedKeys(baseRefs) {
+		if _, ok := revisionRefs[ref]; !ok {
+			d.add(KindVariantRemoved, location, ref, "")
+		}
+	}
+	for _, ref := range sortedKeys(revisionRefs) {
+		if _, ok := baseRefs[ref]; !ok {
+			d.add(KindVariantAdded, location, ref, "")
+		}
+	}
+
+	// inline variants can only be matched by position
+	for i := range min(len(base), len(revision)) {
+		if base[i].Ref == "" && revision[i].Ref == "" {
+			d.compareSchemaRefs(fmt.Sprintf("%s/%d", location, i), base[i], revision[i])
+		}
+	}
+}
+
+func variantRefs(refs openapi3.SchemaRefs) map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, ref := range refs {
+		if ref.Ref != "" {
+			result[refName(ref.Ref)] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+func (d *differ) compareProperties(location string, base, revision *openapi3.Schema) {
+	baseRequired := set(base.Required)
+	revisionRequired := set(revision.Required)
+	location += "/properties"
+
+	for _, name := range sortedKeys(base.Properties) {
+		revisionProperty, ok := revision.Properties[name]
+		if !ok {
+			d.add(KindPropertyRemoved, location, name, "")
+			continue
+		}
+
+		_, wasRequired := baseRequired[name]
+		_, isRequired := revisionRequired[name]
+		switch {
+		case isRequired && !wasRequired:
+			d.add(KindRequiredAdded, location, name, "property became required")
+		case !isRequired && wasRequired:
+			d.add(KindRequiredRemoved, location, name, "property became optional")
+		}
+
+		d.compareSchemaRefs(location+"/"+name, base.Properties[name], revisionProperty)
+	}
+
+	for _, name := range sortedKeys(revision.Properties) {
+		if _, ok := base.Properties[name]; ok {
+			continue
+		}
+
+		if _, required := revisionRequired[name]; required {
+			d.add(KindRequiredAdded, location, name, "new required property")
+			continue
+		}
+		d.add(KindPropertyAdded, location, name, "")
+	}
+}
+
+func schemaTypes(schema *openapi3.Schema) []string {
+	if schema.Type == nil {
+		return nil
+	}
+
+	return slices.Sorted(slices.Values(schema.Type.Slice()))
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+func set(values []string) map[string]struct{} {
+	result := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		result[value] = struct{}{}
+	}
+
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,172 @@
+package schemadiff_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/schemadiff"
+)
+
+const base = `
+openapi: 3.0.3
+info:
+  title: test
+  version: 1.0.0
+paths:
+  /chains/{chain}/tasks:
+    get:
+      operationId: getTasks
+      parameters:
+        - name: chain
+          in: path
+          required: true
+          schema:
+            type: string
+        - name: after
+          in: query
+          schema:
+            type: string
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Task'
+        '404':
+          description: Not found
+components:
+  schemas:
+    TaskType:
+      type: string
+      enum:
+        - EXECUTE
+        - VERIFY
+    Task:
+      type: object
+      properties:
+        type:
+          $ref: '#/components/schemas/TaskType'
+        address:
+          type: string
+          pattern: '^0x[0-9a-f]+$'
+        amount:
+          type: string
+      required:
+        - type
+    OldName:
+      type: object
+      properties:
+        id:
+          type: string
+`
+
+func compare(t *testing.T, revision string) []schemadiff.Change {
+	t.Helper()
+
+	return schemadiff.Compare(
+		funcs.Must(schemadiff.Load([]byte(base))),
+		funcs.Must(schemadiff.Load([]byte(revision))),
+	)
+}
+
+func find(changes []schemadiff.Change, kind schemadiff.Kind, subject string) (schemadiff.Change, bool) {
+	for _, change := range changes {
+		if change.Kind == kind && change.Subject == subject {
+			return change, true
+		}
+	}
+
+	return schemadiff.Change{}, false
+}
+
+func TestCompare(t *testing.T) {
+	t.Run("should find no changes in the same schema", func(t *testing.T) {
+		assert.Empty(t, compare(t, base))
+	})
+
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		kind     schemadiff.Kind
+		subject  string
+		breaking bool
+	}{
+		{"removed enum value", "        - VERIFY\n", "", schemadiff.KindEnumValueRemoved, "VERIFY", true},
+		{"added enum value", "        - VERIFY\n", "        - VERIFY\n        - REFUND\n", schemadiff.KindEnumValueAdded, "REFUND", false},
+		{"new required field", "      required:\n        - type\n", "      required:\n        - type\n        - amount\n", schemadiff.KindRequiredAdded, "amount", true},
+		{"new optional field", "        amount:\n", "        fee:\n          type: string\n        amount:\n", schemadiff.KindPropertyAdded, "fee", false},
+		{"removed field", "        amount:\n          type: string\n", "", schemadiff.KindPropertyRemoved, "amount", true},
+		{"tightened pattern", "'^0x[0-9a-f]+$'", "'^0x[0-9a-f]{40}$'", schemadiff.KindPatternChanged, "address", true},
+		{"removed pattern", "          pattern: '^0x[0-9a-f]+$'\n", "", schemadiff.KindPatternRemoved, "address", false},
+		{"renamed component", "    OldName:", "    NewName:", schemadiff.KindComponentRenamed, "OldName", true},
+		{"changed type", "        amount:\n          type: string\n", "        amount:\n          type: integer\n", schemadiff.KindTypeChanged, "amount", true},
+		{"removed response", "        '404':\n          description: Not found\n", "", schemadiff.KindResponseRemoved, "404", true},
+		{"new required parameter", "          in: query\n", "          in: query\n          required: true\n", schemadiff.KindParameterRequired, "query:after", true},
+		{"deprecated field", "        amount:\n          type: string\n", "        amount:\n          type: string\n          deprecated: true\n", schemadiff.KindDeprecated, "amount", false},
+		{"removed operation", "    get:\n      operationId: getTasks", "    post:\n      operationId: getTasks", schemadiff.KindOperationRemoved, "GET /chains/{chain}/tasks", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Contains(t, base, tc.old)
+			changes := compare(t, strings.Replace(base, tc.old, tc.new, 1))
+
+			change, ok := find(changes, tc.kind, tc.subject)
+			require.True(t, ok, "%s %s not found in %v", tc.kind, tc.subject, changes)
+			assert.Equal(t, tc.breaking, change.Breaking)
+			assert.Equal(t, tc.breaking, schemadiff.HasBreaking(changes))
+		})
+	}
+
+	t.Run("should compare inline schemas moved into components by value", func(t *testing.T) {
+		inline := "        address:\n          type: string\n          pattern: '^0x[0-9a-f]+$'\n"
+		moved := func(pattern string) string {
+			revision := strings.Replace(base, inline, "        address:\n          $ref: '#/components/schemas/Address'\n", 1)
+			return revision + "    Address:\n      type: string\n      description: Hex address\n      pattern: '" + pattern + "'\n"
+		}
+
+		changes := compare(t, moved("^0x[0-9a-f]+$"))
+		assert.False(t, schemadiff.HasBreaking(changes), "%v", changes)
+		_, ok := find(changes, schemadiff.KindComponentAdded, "Address")
+		assert.True(t, ok)
+
+		changes = compare(t, moved("^0x[0-9a-f]{40}$"))
+		_, ok = find(changes, schemadiff.KindPatternChanged, "address")
+		assert.True(t, ok, "%v", changes)
+	})
+
+	t.Run("should report removed component with a different shape as removed", func(t *testing.T) {
+		changes := compare(t, strings.Replace(base, "    OldName:\n      type: object\n      properties:\n        id:", "    NewName:\n      type: object\n      properties:\n        key:", 1))
+
+		_, ok := find(changes, schemadiff.KindComponentRemoved, "OldName")
+		assert.True(t, ok)
+		_, ok = find(changes, schemadiff.KindComponentAdded, "NewName")
+		assert.True(t, ok)
+	})
+}
+
+func TestCompare_CurrentSchema(t *testing.T) {
+	data := funcs.Must(os.ReadFile("../../schema/schema.yaml"))
+	current := funcs.Must(schemadiff.Load(data))
+
+	t.Run("should be compatible with itself", func(t *testing.T) {
+		assert.Empty(t, schemadiff.Compare(current, current))
+	})
+
+	t.Run("should flag renamed event types as breaking", func(t *testing.T) {
+		renamed := funcs.Must(schemadiff.Load([]byte(strings.ReplaceAll(string(data), "- APP/INTERCHAIN_TRANSFER_SENT", "- ITS_APP/INTERCHAIN_TRANSFER_SENT"))))
+
+		changes := schemadiff.Compare(current, renamed)
+		change, ok := find(changes, schemadiff.KindEnumValueRemoved, "APP/INTERCHAIN_TRANSFER_SENT")
+		require.True(t, ok)
+		assert.True(t, change.Breaking)
+		assert.Equal(t, "EventType", change.Component())
+	})
+}
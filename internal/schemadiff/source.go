@@ -0,0 +1,52 @@
+package schemadiff
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ReadVersion reads a version of the schema. If source is an existing file, it's read as is.
+// Otherwise, source is treated as a git revision, and schemaPath is read at that revision.
+func ReadVersion(source, schemaPath string) ([]byte, error) {
+	if info, err := os.Stat(source); err == nil && !info.IsDir() {
+		return os.ReadFile(source)
+	}
+
+	relPath := schemaPath
+	if filepath.IsAbs(schemaPath) {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+
+		if relPath, err = filepath.Rel(wd, schemaPath); err != nil {
+			return nil, err
+		}
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "show", fmt.Sprintf("%s:./%s", source, filepath.ToSlash(relPath)))
+	cmd.Stderr = &stderr
+
+	data, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s is neither a file nor a git revision with %s: %w: %s", source, schemaPath, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	return data, nil
+}
+
+// LoadVersion reads a version of the schema with ReadVersion and loads it
+func LoadVersion(source, schemaPath string) (*openapi3.T, error) {
+	data, err := ReadVersion(source, schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return Load(data)
+}
@@ -0,0 +1,486 @@
+package memserver
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+// PublishEvents implements api.ServerInterface.
+// Each event is validated independently: invalid events are rejected with a non-retriable error, valid ones are accepted.
+// Events with an already accepted eventID are accepted again without being stored twice.
+func (s *Server) PublishEvents(c *gin.Context, chain api.Chain) {
+	var request api.PublishEventsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(request.Events) == 0 {
+		respondError(c, http.StatusBadRequest, errors.New("events cannot be empty"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[chain]
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("%w: %s", ErrChainNotFound, chain))
+		return
+	}
+
+	results := make([]api.PublishEventResultItem, 0, len(request.Events))
+	for i, event := range request.Events {
+		var item api.PublishEventResultItem
+
+		if err := validateEvent(event); err != nil {
+			_ = item.FromPublishEventErrorResult(api.PublishEventErrorResult{
+				Index:     i,
+				Status:    api.PublishEventStatusError,
+				Error:     err.Error(),
+				Retriable: false,
+			})
+			results = append(results, item)
+			continue
+		}
+
+		eventID := event.EventID()
+		if _, exists := state.ingested[eventID]; !exists {
+			state.ingested[eventID] = &api.GetEventResult{
+				Event:        event,
+				Status:       api.EventIngestionStatusIndexed,
+				CrossChainID: linkedMessage(chain, event),
+				ReceivedAt:   s.now(),
+			}
+			state.events = append(state.events, event)
+		}
+
+		_ = item.FromPublishEventAcceptedResult(api.PublishEventAcceptedResult{
+			Index:  i,
+			Status: api.PublishEventStatusAccepted,
+		})
+		results = append(results, item)
+	}
+
+	c.JSON(http.StatusOK, api.PublishEventsResult{Results: results})
+}
+
+// GetChains implements api.ServerInterface. Chains are listed by name, chains registered without metadata are left out.
+func (s *Server) GetChains(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chains := make([]api.ChainInfo, 0, len(s.chains))
+	for _, name := range slices.Sorted(maps.Keys(s.chains)) {
+		if info := s.chains[name].info; info != nil {
+			chains = append(chains, *info)
+		}
+	}
+
+	c.JSON(http.StatusOK, api.GetChainsResult{Chains: chains})
+}
+
+// GetChain implements api.ServerInterface. Chains registered without metadata aren't found.
+func (s *Server) GetChain(c *gin.Context, chain api.Chain) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[chain]
+	if !ok || state.info == nil {
+		respondError(c, http.StatusNotFound, fmt.Errorf("%w: %s", ErrChainNotFound, chain))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.GetChainResult{Chain: *state.info})
+}
+
+// GetEvent implements api.ServerInterface. Accepted events are INDEXED right away, unless changed with SetEventStatus.
+func (s *Server) GetEvent(c *gin.Context, chain api.Chain, eventID api.EventID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[chain]
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("%w: %s", ErrChainNotFound, chain))
+		return
+	}
+
+	result, ok := state.ingested[eventID]
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("event %s not found", eventID))
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetMessageStatus implements api.ServerInterface. The status is derived from events and tasks of all chains that refer to the message.
+func (s *Server) GetMessageStatus(c *gin.Context, sourceChain api.SourceChain, messageID api.MessageID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status, ok := s.messageStatus(api.CrossChainID{SourceChain: sourceChain, MessageID: messageID})
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("message %s from %s not found", messageID, sourceChain))
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// GetTasks implements api.ServerInterface.
+// Tasks are filtered by type if requested. If no tasks follow the cursor, the request is held for up to wait seconds until a task is enqueued.
+func (s *Server) GetTasks(c *gin.Context, chain api.Chain, params api.GetTasksParams) {
+	limit := defaultTasksLimit
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+
+	var types []api.TaskType
+	if params.Type != nil {
+		types = *params.Type
+	}
+
+	var wait time.Duration
+	if params.Wait != nil {
+		wait = time.Duration(min(max(*params.Wait, 0), maxTasksWait)) * time.Second
+	}
+
+	s.mu.Lock()
+	state, ok := s.chains[chain]
+	s.mu.Unlock()
+
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("%w: %s", ErrChainNotFound, chain))
+		return
+	}
+
+	deadline := time.NewTimer(wait)
+	defer deadline.Stop()
+
+	for {
+		s.mu.Lock()
+		tasks := s.tasksAfter(state, params.After, limit, types)
+		enqueued := state.enqueued
+		s.mu.Unlock()
+
+		if len(tasks) > 0 || wait == 0 {
+			c.JSON(http.StatusOK, api.GetTasksResult{Tasks: tasks})
+			return
+		}
+
+		select {
+		case <-enqueued:
+		case <-deadline.C:
+			// respond with whatever follows the cursor now
+			wait = 0
+		case <-c.Request.Context().Done():
+			return
+		}
+	}
+}
+
+// StreamTasks implements api.ServerInterface.
+// It writes the tasks following the cursor, then waits for new tasks until the client disconnects, sending heartbeats while idle.
+func (s *Server) StreamTasks(c *gin.Context, chain api.Chain, params api.StreamTasksParams) {
+	after := params.After
+	if params.LastEventID != nil {
+		after = params.LastEventID
+	}
+
+	s.mu.Lock()
+	state, ok := s.chains[chain]
+	s.mu.Unlock()
+
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("%w: %s", ErrChainNotFound, chain))
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(s.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		s.mu.Lock()
+		tasks := s.tasksAfter(state, after, len(state.tasks), nil)
+		enqueued := state.enqueued
+		s.mu.Unlock()
+
+		for _, task := range tasks {
+			data, err := json.Marshal(task)
+			if err != nil {
+				return
+			}
+
+			if err := sse.Encode(c.Writer, sse.Event{Id: task.ID.String(), Event: api.TaskStreamEvent, Data: string(data)}); err != nil {
+				return
+			}
+			after = &task.ID
+		}
+		c.Writer.Flush()
+
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-enqueued:
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, ":\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// BatchGetTasks implements api.Server
+func (s *Server) BatchGetTasks(c *gin.Context, chain api.Chain) {
+	var request api.BatchGetTasksRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(request.Ids) == 0 || len(request.Ids) > api.MaxBatchGetTaskIDs {
+		respondError(c, http.StatusBadRequest, fmt.Errorf("between 1 and %d ids are required", api.MaxBatchGetTaskIDs))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[chain]
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("%w: %s", ErrChainNotFound, chain))
+		return
+	}
+
+	result := api.BatchGetTasksResult{Tasks: []api.TaskItem{}, Missing: []api.TaskItemID{}}
+	seen := make(map[uuid.UUID]bool, len(request.Ids))
+	for _, id := range request.Ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		i := slices.IndexFunc(state.tasks, func(t api.TaskItem) bool { return t.ID == id })
+		if i < 0 {
+			result.Missing = append(result.Missing, id)
+			continue
+		}
+		result.Tasks = append(result.Tasks, state.tasks[i])
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetTask implements api.ServerInterface
+func (s *Server) GetTask(c *gin.Context, chain api.Chain, taskItemID api.TaskItemID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[chain]
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("%w: %s", ErrChainNotFound, chain))
+		return
+	}
+
+	i := slices.IndexFunc(state.tasks, func(t api.TaskItem) bool { return t.ID == taskItemID })
+	if i < 0 {
+		respondError(c, http.StatusNotFound, fmt.Errorf("task %s not found", taskItemID))
+		return
+	}
+
+	c.JSON(http.StatusOK, api.GetTaskResult{Task: state.tasks[i]})
+}
+
+// BroadcastMsgExecuteContract implements api.ServerInterface. The broadcast stays RECEIVED until Server.CompleteBroadcast is called.
+func (s *Server) BroadcastMsgExecuteContract(c *gin.Context, wasmContractAddress api.WasmContractAddress) {
+	var request api.WasmRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if request.Kind() == api.WasmRequestKindUnknown {
+		respondError(c, http.StatusBadRequest, errors.New("request must be an object or a non-empty string"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := uuid.New()
+	s.broadcasts[id] = &broadcast{
+		contract: wasmContractAddress,
+		request:  request,
+		status: api.BroadcastStatusResponse{
+			Status:     api.BroadcastStatusReceived,
+			ReceivedAt: s.now(),
+		},
+	}
+
+	c.JSON(http.StatusOK, api.BroadcastResponse{BroadcastID: id})
+}
+
+// GetMsgExecuteContractBroadcastStatus implements api.ServerInterface
+func (s *Server) GetMsgExecuteContractBroadcastStatus(c *gin.Context, wasmContractAddress api.WasmContractAddress, broadcastID api.BroadcastID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.broadcasts[broadcastID]
+	if !ok || b.contract != wasmContractAddress {
+		respondError(c, http.StatusNotFound, fmt.Errorf("broadcast %s not found", broadcastID))
+		return
+	}
+
+	c.JSON(http.StatusOK, b.status)
+}
+
+// QueryContractState implements api.ServerInterface
+func (s *Server) QueryContractState(c *gin.Context, wasmContractAddress api.WasmContractAddress) {
+	var request api.WasmRequest
+	if err := c.ShouldBindJSON(&request); err != nil || request.Kind() == api.WasmRequestKindUnknown {
+		respondError(c, http.StatusBadRequest, errors.New("request must be an object or a non-empty string"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response, ok := s.contracts[wasmContractAddress]
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("contract %s not found", wasmContractAddress))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// HealthCheck implements api.ServerInterface
+func (s *Server) HealthCheck(c *gin.Context) {
+	c.Status(http.StatusOK)
+}
+
+// AckTask implements api.ServerInterface. DONE is final, other statuses replace the previous acknowledgement.
+func (s *Server) AckTask(c *gin.Context, chain api.Chain, taskItemID api.TaskItemID) {
+	var request api.AckTaskRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	switch request.Status {
+	case api.TaskAckStatusProcessing, api.TaskAckStatusDone, api.TaskAckStatusFailed:
+	default:
+		respondError(c, http.StatusBadRequest, fmt.Errorf("unknown status %q", request.Status))
+		return
+	}
+
+	var details string
+	if request.Details != nil {
+		details = *request.Details
+	}
+	if len(details) > maxAckDetails {
+		respondError(c, http.StatusBadRequest, fmt.Errorf("details exceed %d characters", maxAckDetails))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[chain]
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("%w: %s", ErrChainNotFound, chain))
+		return
+	}
+
+	if !slices.ContainsFunc(state.tasks, func(t api.TaskItem) bool { return t.ID == taskItemID }) {
+		respondError(c, http.StatusNotFound, fmt.Errorf("task %s not found", taskItemID))
+		return
+	}
+
+	if previous, ok := state.acks[taskItemID]; ok && previous.Status == api.TaskAckStatusDone {
+		if request.Status != api.TaskAckStatusDone {
+			respondError(c, http.StatusConflict, fmt.Errorf("task %s is already done", taskItemID))
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	state.acks[taskItemID] = TaskAck{Status: request.Status, Details: details, AcknowledgedAt: s.now()}
+	c.Status(http.StatusNoContent)
+}
+
+// StorePayload implements api.ServerInterface
+func (s *Server) StorePayload(c *gin.Context) {
+	payload, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(payload) == 0 {
+		respondError(c, http.StatusBadRequest, errors.New("payload cannot be empty"))
+		return
+	}
+
+	hash := "0x" + hex.EncodeToString(keccak256(payload))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.payloads[hash] = payload
+
+	c.JSON(http.StatusOK, api.StorePayloadResult{Keccak256: hash})
+}
+
+// GetPayload implements api.ServerInterface
+func (s *Server) GetPayload(c *gin.Context, hash api.Keccak256Hash) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	payload, ok := s.payloads[hash]
+	if !ok {
+		respondError(c, http.StatusNotFound, fmt.Errorf("payload %s not found", hash))
+		return
+	}
+
+	c.Data(http.StatusOK, "application/octet-stream", payload)
+}
+
+func validateEvent(event api.Event) error {
+	eventID, err := event.GetEventID()
+	if err != nil {
+		return err
+	}
+
+	if eventID == "" {
+		return errors.New("eventID is required")
+	}
+
+	return event.Validate()
+}
+
+func respondError(c *gin.Context, status int, err error) {
+	c.JSON(status, api.ErrorResponse{Error: err.Error()})
+}
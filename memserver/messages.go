@@ -0,0 +1,177 @@
+package memserver
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+// stageRanks orders message stages by progress. A message only moves to a stage of higher rank,
+// so that e.g. a failed execution attempt doesn't hide a later successful one.
+var stageRanks = map[api.MessageStage]int{
+	api.MessageStageCalled:   1,
+	api.MessageStageVerified: 2,
+	api.MessageStageApproved: 3,
+	api.MessageStageFailed:   4,
+	api.MessageStageExecuted: 5,
+}
+
+// messageEvent holds the fields of an event that the status of its message is derived from
+type messageEvent struct {
+	Type       api.EventType              `json:"type"`
+	Status     api.MessageExecutionStatus `json:"status"`
+	TaskItemID *uuid.UUID                 `json:"taskItemID"`
+	Cost       *api.Cost                  `json:"cost"`
+}
+
+// messageStatus derives the status of the message from accepted events and enqueued tasks of all chains.
+// It returns false if neither refers to the message. Must be called with s.mu held.
+func (s *Server) messageStatus(id api.CrossChainID) (api.GetMessageStatusResult, bool) {
+	status := api.GetMessageStatusResult{
+		CrossChainID: id,
+		TaskIDs:      []api.TaskItemID{},
+		Costs:        api.Fees{},
+	}
+	chains := slices.Sorted(maps.Keys(s.chains))
+
+	var tasks []api.TaskItem
+	for _, chain := range chains {
+		for _, task := range s.chains[chain].tasks {
+			if message := taskMessage(task); message != nil && *message == id {
+				tasks = append(tasks, task)
+			}
+		}
+	}
+	slices.SortStableFunc(tasks, func(a, b api.TaskItem) int { return a.Timestamp.Compare(b.Timestamp) })
+
+	linkedTasks := make(map[uuid.UUID]bool, len(tasks))
+	for _, task := range tasks {
+		linkedTasks[task.ID] = true
+		status.TaskIDs = append(status.TaskIDs, task.ID)
+		touch(&status, task.Timestamp)
+
+		// the Amplifier only constructs proofs of messages that were verified and routed to the destination chain
+		if task.Type == api.TaskTypeConstructProof {
+			advance(&status, api.MessageStageVerified, &status.VerifiedAt, task.Timestamp)
+		}
+	}
+
+	found := len(tasks) > 0
+	for _, chain := range chains {
+		state := s.chains[chain]
+		for _, event := range state.events {
+			eventID := event.EventID()
+			result := state.ingested[eventID]
+
+			fields, ok := decodeMessageEvent(event)
+			if !ok {
+				continue
+			}
+
+			linked := result.CrossChainID != nil && *result.CrossChainID == id
+			if !linked && (fields.TaskItemID == nil || !linkedTasks[*fields.TaskItemID]) {
+				continue
+			}
+
+			found = true
+			touch(&status, result.ReceivedAt)
+			status.Costs = append(status.Costs, eventCosts(eventID, fields.Cost)...)
+
+			switch fields.Type {
+			case api.EventTypeCall:
+				advance(&status, api.MessageStageCalled, &status.CalledAt, result.ReceivedAt)
+			case api.EventTypeMessageApproved:
+				advance(&status, api.MessageStageApproved, &status.ApprovedAt, result.ReceivedAt)
+			case api.EventTypeMessageExecuted:
+				if fields.Status == api.MessageExecutionStatusReverted {
+					advance(&status, api.MessageStageFailed, nil, result.ReceivedAt)
+					break
+				}
+				advance(&status, api.MessageStageExecuted, &status.ExecutedAt, result.ReceivedAt)
+			case api.EventTypeMessageExecutedV2:
+				advance(&status, api.MessageStageExecuted, &status.ExecutedAt, result.ReceivedAt)
+			case api.EventTypeCannotExecuteMessage, api.EventTypeCannotExecuteMessageV2, api.EventTypeCannotRouteMessage:
+				advance(&status, api.MessageStageFailed, nil, result.ReceivedAt)
+			default:
+			}
+		}
+	}
+
+	// a message is known to exist once anything refers to it, e.g. GAS_CREDIT events can be published before the CALL
+	if status.Stage == "" {
+		status.Stage = api.MessageStageCalled
+	}
+
+	return status, found
+}
+
+// advance moves the message to the stage if it's further than the current one, and records when the stage was first reached
+func advance(status *api.GetMessageStatusResult, stage api.MessageStage, reachedAt **time.Time, at time.Time) {
+	if stageRanks[stage] > stageRanks[status.Stage] {
+		status.Stage = stage
+	}
+
+	if reachedAt != nil && *reachedAt == nil {
+		*reachedAt = &at
+	}
+}
+
+func touch(status *api.GetMessageStatusResult, at time.Time) {
+	if at.After(status.UpdatedAt) {
+		status.UpdatedAt = at
+	}
+}
+
+// taskMessage returns the message the task was created for, if any
+func taskMessage(task api.TaskItem) *api.CrossChainID {
+	data, err := task.Task.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+
+	var fields struct {
+		Message *api.Message `json:"message"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil || fields.Message == nil {
+		return nil
+	}
+
+	return &api.CrossChainID{SourceChain: fields.Message.SourceChain, MessageID: fields.Message.MessageID}
+}
+
+func decodeMessageEvent(event api.Event) (messageEvent, bool) {
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return messageEvent{}, false
+	}
+
+	var fields messageEvent
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return messageEvent{}, false
+	}
+
+	return fields, true
+}
+
+// eventCosts returns the cost of an event as fees. A cost given as a single token becomes a fee identified by the event ID.
+func eventCosts(eventID string, cost *api.Cost) api.Fees {
+	if cost == nil {
+		return nil
+	}
+
+	if fees, err := cost.AsFees(); err == nil {
+		return fees
+	}
+
+	token, err := cost.AsUnsignedToken()
+	if err != nil {
+		return nil
+	}
+
+	return api.Fees{{ID: eventID, Token: token}}
+}
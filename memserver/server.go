@@ -0,0 +1,353 @@
+// Package memserver provides an in-memory reference implementation of api.Server.
+// It's meant for tests of relayers and for running the conformance suite, not for production use.
+package memserver
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+const (
+	defaultTasksLimit = 20
+	defaultHeartbeat  = 15 * time.Second
+
+	// maxTasksWait is the maximum wait of GetTasks in seconds, as specified by the schema
+	maxTasksWait = 60
+	// maxAckDetails is the maximum length of AckTask details, as specified by the schema
+	maxAckDetails = 1000
+)
+
+// ErrChainNotFound is an error when the chain isn't registered
+var ErrChainNotFound = errors.New("chain not found")
+
+var _ api.Server = (*Server)(nil)
+
+// Server is an in-memory implementation of api.Server. It's safe for concurrent use.
+type Server struct {
+	mu sync.Mutex
+
+	chains     map[string]*chainState
+	contracts  map[api.WasmContractAddress]api.ContractQueryResponse
+	broadcasts map[api.BroadcastID]*broadcast
+	payloads   map[api.Keccak256Hash][]byte
+	now        func() time.Time
+	heartbeat  time.Duration
+}
+
+type chainState struct {
+	// info is nil for chains registered without metadata, which aren't listed by GetChains
+	info   *api.ChainInfo
+	tasks  []api.TaskItem
+	events []api.Event
+	// ingested keeps accepted events by ID, so that republished events aren't stored twice
+	ingested map[string]*api.GetEventResult
+	// enqueued is closed and replaced whenever a task is enqueued, so that streams waiting for tasks wake up
+	enqueued chan struct{}
+	acks     map[uuid.UUID]TaskAck
+}
+
+// TaskAck is the latest acknowledgement of a task
+type TaskAck struct {
+	Status         api.TaskAckStatus
+	Details        string
+	AcknowledgedAt time.Time
+}
+
+type broadcast struct {
+	contract api.WasmContractAddress
+	request  api.WasmRequest
+	status   api.BroadcastStatusResponse
+}
+
+// Option configures Server
+type Option func(*Server)
+
+// WithChains registers chains at construction
+func WithChains(chains ...string) Option {
+	return func(s *Server) {
+		for _, chain := range chains {
+			s.chains[chain] = newChainState()
+		}
+	}
+}
+
+// WithChainInfo registers chains with their metadata at construction
+func WithChainInfo(infos ...api.ChainInfo) Option {
+	return func(s *Server) {
+		for _, info := range infos {
+			state := newChainState()
+			state.info = &info
+			s.chains[info.Name] = state
+		}
+	}
+}
+
+// WithClock overrides the source of timestamps, e.g. to make them deterministic in tests
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// WithHeartbeat sets the interval of heartbeats on idle task streams. Defaults to 15s.
+func WithHeartbeat(interval time.Duration) Option {
+	return func(s *Server) {
+		s.heartbeat = interval
+	}
+}
+
+// New creates an empty Server
+func New(opts ...Option) *Server {
+	s := &Server{
+		chains:     make(map[string]*chainState),
+		contracts:  make(map[api.WasmContractAddress]api.ContractQueryResponse),
+		broadcasts: make(map[api.BroadcastID]*broadcast),
+		payloads:   make(map[api.Keccak256Hash][]byte),
+		now:        time.Now,
+		heartbeat:  defaultHeartbeat,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func newChainState() *chainState {
+	return &chainState{
+		ingested: make(map[string]*api.GetEventResult),
+		enqueued: make(chan struct{}),
+		acks:     make(map[uuid.UUID]TaskAck),
+	}
+}
+
+// RegisterChain makes the chain known to the server. Registering a chain twice is a no-op.
+func (s *Server) RegisterChain(chain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.chains[chain]; !ok {
+		s.chains[chain] = newChainState()
+	}
+}
+
+// RegisterChainInfo makes the chain known to the server if it isn't yet and sets its metadata returned by GetChains and GetChain
+func (s *Server) RegisterChainInfo(info api.ChainInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[info.Name]
+	if !ok {
+		state = newChainState()
+		s.chains[info.Name] = state
+	}
+
+	state.info = &info
+}
+
+// EnqueueTask appends the task to the queue of its chain. Tasks are returned by GetTasks in the order they're enqueued.
+func (s *Server) EnqueueTask(task api.TaskItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[task.Chain]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrChainNotFound, task.Chain)
+	}
+
+	if slices.ContainsFunc(state.tasks, func(t api.TaskItem) bool { return t.ID == task.ID }) {
+		return fmt.Errorf("task %s already exists", task.ID)
+	}
+
+	state.tasks = append(state.tasks, task)
+
+	close(state.enqueued)
+	state.enqueued = make(chan struct{})
+
+	return nil
+}
+
+// TaskAck returns the latest acknowledgement of the task, if it was acknowledged
+func (s *Server) TaskAck(chain string, taskItemID uuid.UUID) (TaskAck, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[chain]
+	if !ok {
+		return TaskAck{}, false
+	}
+
+	ack, ok := state.acks[taskItemID]
+	return ack, ok
+}
+
+// SetEventStatus changes the ingestion status of an accepted event, e.g. to FAILED with the reason in failure
+func (s *Server) SetEventStatus(chain, eventID string, status api.EventIngestionStatus, failure string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[chain]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrChainNotFound, chain)
+	}
+
+	result, ok := state.ingested[eventID]
+	if !ok {
+		return fmt.Errorf("event %s not found", eventID)
+	}
+
+	result.Status = status
+	result.Error = nil
+	if failure != "" {
+		result.Error = &failure
+	}
+
+	return nil
+}
+
+// Events returns events accepted for the chain in the order they were published
+func (s *Server) Events(chain string) []api.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.chains[chain]
+	if !ok {
+		return nil
+	}
+
+	return slices.Clone(state.events)
+}
+
+// RegisterContract makes the contract known to the server. Queries to the contract return the response regardless of the request.
+func (s *Server) RegisterContract(contract api.WasmContractAddress, queryResponse api.ContractQueryResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.contracts[contract] = queryResponse
+}
+
+// CompleteBroadcast transitions a RECEIVED broadcast to SUCCESS with the given tx events, or to ERROR if broadcastErr isn't nil
+func (s *Server) CompleteBroadcast(broadcastID api.BroadcastID, txEvents []api.WasmEvent, broadcastErr error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.broadcasts[broadcastID]
+	if !ok {
+		return fmt.Errorf("broadcast %s not found", broadcastID)
+	}
+
+	if b.status.Status != api.BroadcastStatusReceived {
+		return fmt.Errorf("broadcast %s is already %s", broadcastID, b.status.Status)
+	}
+
+	completedAt := s.now()
+	b.status.CompletedAt = &completedAt
+
+	if broadcastErr != nil {
+		msg := broadcastErr.Error()
+		b.status.Status = api.BroadcastStatusError
+		b.status.Error = &msg
+		return nil
+	}
+
+	txHash := hex.EncodeToString(keccak256([]byte(broadcastID.String())))
+	b.status.Status = api.BroadcastStatusSuccess
+	b.status.TxHash = &txHash
+	if len(txEvents) > 0 {
+		events := slices.Clone(txEvents)
+		b.status.TxEvents = &events
+	}
+
+	return nil
+}
+
+// Broadcasts returns IDs of broadcasts received for the contract
+func (s *Server) Broadcasts(contract api.WasmContractAddress) []api.BroadcastID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ids []api.BroadcastID
+	for id, b := range s.broadcasts {
+		if b.contract == contract {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// tasksAfter returns at most limit tasks of the chain following the task with the given ID, of the given types if any.
+// If the ID isn't known, tasks are returned from the beginning of the queue, so that no task is skipped.
+// Must be called with s.mu held.
+func (s *Server) tasksAfter(state *chainState, after *uuid.UUID, limit int, types []api.TaskType) []api.TaskItem {
+	start := 0
+	if after != nil {
+		if i := slices.IndexFunc(state.tasks, func(t api.TaskItem) bool { return t.ID == *after }); i >= 0 {
+			start = i + 1
+		}
+	}
+
+	tasks := make([]api.TaskItem, 0, min(limit, len(state.tasks)-start))
+	for _, task := range state.tasks[start:] {
+		if len(tasks) == limit {
+			break
+		}
+
+		if len(types) > 0 && !slices.Contains(types, task.Type) {
+			continue
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks
+}
+
+// linkedMessage returns the message the event refers to, if any.
+// Events that only carry a message ID, e.g. GAS_CREDIT, refer to messages sent from the chain they're published on.
+func linkedMessage(chain string, event api.Event) *api.CrossChainID {
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+
+	var fields struct {
+		CrossChainID *api.CrossChainID `json:"crossChainID"`
+		Message      *api.Message      `json:"message"`
+		MessageID    string            `json:"messageID"`
+		SourceChain  string            `json:"sourceChain"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	switch {
+	case fields.CrossChainID != nil:
+		return fields.CrossChainID
+	case fields.Message != nil:
+		return &api.CrossChainID{SourceChain: fields.Message.SourceChain, MessageID: fields.Message.MessageID}
+	case fields.MessageID != "" && fields.SourceChain != "":
+		return &api.CrossChainID{SourceChain: fields.SourceChain, MessageID: fields.MessageID}
+	case fields.MessageID != "":
+		return &api.CrossChainID{SourceChain: chain, MessageID: fields.MessageID}
+	default:
+		return nil
+	}
+}
+
+func keccak256(data []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
@@ -0,0 +1,127 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/atomicfile"
+)
+
+const logFile = "outbox.jsonl"
+
+var _ Storage = (*FileStorage)(nil)
+
+// FileStorage is a Storage keeping the log as a JSON lines file in a directory.
+// Every write is fsync'd before it returns. It's safe for concurrent use within a process.
+type FileStorage struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileStorage creates a FileStorage in dir. The directory is created if it doesn't exist.
+func NewFileStorage(dir string) (*FileStorage, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create outbox directory: %w", err)
+	}
+
+	return &FileStorage{dir: dir}, nil
+}
+
+// Append implements Storage
+func (s *FileStorage) Append(_ context.Context, records ...Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return appendLines(filepath.Join(s.dir, logFile), records)
+}
+
+// Records implements Storage. A partial last line, left by a crash during Append, is ignored.
+func (s *FileStorage) Records(_ context.Context) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return readLines[Record](filepath.Join(s.dir, logFile))
+}
+
+// Compact implements Storage. A crash leaves either the old or the new log.
+func (s *FileStorage) Compact(_ context.Context, records []Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := encodeLines(records)
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.Write(filepath.Join(s.dir, logFile), data)
+}
+
+func appendLines[T any](path string, values []T) error {
+	data, err := encodeLines(values)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func encodeLines[T any](values []T) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes(), nil
+}
+
+func readLines[T any](path string) ([]T, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lines := bytes.Split(data, []byte{'\n'})
+	// the last line is either empty or partial, left by a crash during a write
+	lines = lines[:len(lines)-1]
+
+	values := make([]T, 0, len(lines))
+	for i, line := range lines {
+		var value T
+		if err := json.Unmarshal(line, &value); err != nil {
+			return nil, fmt.Errorf("failed to parse line %d of %s: %w", i+1, path, err)
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,427 @@
+// Package outbox persists events before they are published with PublishEvents, so that events observed on chain survive crashes.
+//
+// Events are appended to a durable log by Add and published by Flush. Accepted events are marked done,
+// events failing with retriable errors stay pending, and events failing with non-retriable errors are kept in the log as rejections.
+// Open replays the log, so that events pending before a restart are published again. Event IDs must be stable, see package eventid,
+// so that republishing an event the backend accepted before the crash is idempotent.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+const (
+	defaultInterval         = 5 * time.Second
+	defaultBatchSize        = 100
+	defaultCompactThreshold = 1000
+)
+
+// ErrPublish is an error when events couldn't be published. The events stay pending.
+var ErrPublish = errors.New("failed to publish events")
+
+// FlushResult summarizes a Flush
+type FlushResult struct {
+	Accepted int
+	Retried  int
+	Rejected int
+}
+
+type entry struct {
+	seq   uint64
+	chain string
+	event api.Event
+}
+
+// Outbox publishes events durably. It's safe for concurrent use.
+type Outbox struct {
+	storage Storage
+	client  api.ClientWithResponsesInterface
+
+	interval         time.Duration
+	batchSize        int
+	compactThreshold int
+	now              func() time.Time
+
+	// flushMu serializes flushes, so that an event is never in flight twice
+	flushMu sync.Mutex
+	// done counts the events marked done since the log was last compacted
+	done int
+
+	mu      sync.Mutex
+	nextSeq uint64
+	pending []entry
+}
+
+// Option configures Outbox
+type Option func(*Outbox)
+
+// WithInterval sets the delay between flushes of Run. Defaults to 5s.
+func WithInterval(interval time.Duration) Option {
+	return func(o *Outbox) {
+		o.interval = interval
+	}
+}
+
+// WithBatchSize sets the maximum number of events published at once. Defaults to 100, the maximum accepted by PublishEvents.
+func WithBatchSize(size int) Option {
+	return func(o *Outbox) {
+		o.batchSize = size
+	}
+}
+
+// WithCompactThreshold sets the number of events marked done after which Flush compacts the log. Defaults to 1000.
+func WithCompactThreshold(threshold int) Option {
+	return func(o *Outbox) {
+		o.compactThreshold = threshold
+	}
+}
+
+// WithClock sets the clock used to timestamp rejections
+func WithClock(now func() time.Time) Option {
+	return func(o *Outbox) {
+		o.now = now
+	}
+}
+
+// Open replays the log of the storage and returns an Outbox with the events that were pending.
+// The log is compacted to the rejections and the pending events.
+func Open(ctx context.Context, storage Storage, client api.ClientWithResponsesInterface, opts ...Option) (*Outbox, error) {
+	o := &Outbox{
+		storage:          storage,
+		client:           client,
+		interval:         defaultInterval,
+		batchSize:        defaultBatchSize,
+		compactThreshold: defaultCompactThreshold,
+		now:              time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	records, err := storage.Records(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outbox log: %w", err)
+	}
+
+	o.pending = replay(records)
+	for _, record := range records {
+		o.nextSeq = max(o.nextSeq, record.Seq+1)
+	}
+
+	if err := o.compact(ctx); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// Add validates the events and appends them to the log. The events are durable when Add returns.
+func (o *Outbox) Add(ctx context.Context, chain string, events ...api.Event) error {
+	for i, event := range events {
+		if err := validate(event); err != nil {
+			return fmt.Errorf("invalid event %d: %w", i, err)
+		}
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	entries := make([]entry, 0, len(events))
+	records := make([]Record, 0, len(events))
+	for i, event := range events {
+		e := entry{seq: o.nextSeq + uint64(i), chain: chain, event: event}
+		entries = append(entries, e)
+		records = append(records, appended(e))
+	}
+
+	if err := o.storage.Append(ctx, records...); err != nil {
+		return fmt.Errorf("failed to append events: %w", err)
+	}
+
+	o.nextSeq += uint64(len(events))
+	o.pending = append(o.pending, entries...)
+
+	return nil
+}
+
+// Pending returns the number of events that weren't published yet
+func (o *Outbox) Pending() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return len(o.pending)
+}
+
+// Run flushes the outbox until ctx is done. Errors publishing events are retried on the next tick, storage errors stop Run.
+func (o *Outbox) Run(ctx context.Context) error {
+	for {
+		if _, err := o.Flush(ctx); err != nil && !errors.Is(err, ErrPublish) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(o.interval):
+		}
+	}
+}
+
+// Flush publishes the pending events in batches per chain, in the order they were added.
+// Batches that fail as a whole stay pending and Flush returns ErrPublish after trying all chains.
+func (o *Outbox) Flush(ctx context.Context) (FlushResult, error) {
+	o.flushMu.Lock()
+	defer o.flushMu.Unlock()
+
+	var result FlushResult
+	var errs []error
+	for _, batch := range o.batches() {
+		if err := o.publish(ctx, batch, &result); err != nil {
+			if !errors.Is(err, ErrPublish) {
+				return result, err
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func (o *Outbox) batches() [][]entry {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var batches [][]entry
+	current := make(map[string]int)
+	for _, e := range o.pending {
+		i, ok := current[e.chain]
+		if !ok || len(batches[i]) == o.batchSize {
+			i = len(batches)
+			batches = append(batches, nil)
+			current[e.chain] = i
+		}
+		batches[i] = append(batches[i], e)
+	}
+
+	return batches
+}
+
+func (o *Outbox) publish(ctx context.Context, batch []entry, result *FlushResult) error {
+	chain := batch[0].chain
+
+	events := make([]api.Event, 0, len(batch))
+	for _, e := range batch {
+		events = append(events, e.event)
+	}
+
+	res, err := o.client.PublishEventsWithResponse(ctx, chain, api.PublishEventsRequest{Events: events})
+	if err != nil {
+		return fmt.Errorf("%w to chain %s: %w", ErrPublish, chain, err)
+	}
+
+	if res.StatusCode() != http.StatusOK || res.JSON200 == nil {
+		return fmt.Errorf("%w to chain %s: unexpected status %s", ErrPublish, chain, res.Status())
+	}
+
+	var done []Record
+	for _, item := range res.JSON200.Results {
+		record, err := o.resolve(batch, item)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case record == nil:
+			result.Retried++
+			continue
+		case record.Op == OpRejected:
+			result.Rejected++
+		default:
+			result.Accepted++
+		}
+
+		done = append(done, *record)
+	}
+
+	if len(done) == 0 {
+		return nil
+	}
+
+	// rejections are written with the accepted events in one append, so that a crash can't publish rejected events again
+	if err := o.storage.Append(ctx, done...); err != nil {
+		return fmt.Errorf("failed to mark events done: %w", err)
+	}
+
+	o.remove(done)
+
+	o.done += len(done)
+	if o.done >= o.compactThreshold {
+		return o.compact(ctx)
+	}
+
+	return nil
+}
+
+// Rejections returns the events the backend rejected with non-retriable errors, in the order they were rejected
+func (o *Outbox) Rejections(ctx context.Context) ([]Rejection, error) {
+	records, err := o.storage.Records(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read outbox log: %w", err)
+	}
+
+	var rejections []Rejection
+	for _, record := range records {
+		if record.Op == OpRejected && record.Rejection != nil {
+			rejections = append(rejections, *record.Rejection)
+		}
+	}
+
+	return rejections, nil
+}
+
+// compact replaces the log with the rejections and the pending events
+func (o *Outbox) compact(ctx context.Context) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	records, err := o.storage.Records(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read outbox log: %w", err)
+	}
+
+	var compacted []Record
+	for _, record := range records {
+		if record.Op == OpRejected {
+			compacted = append(compacted, record)
+		}
+	}
+
+	for _, e := range o.pending {
+		compacted = append(compacted, appended(e))
+	}
+
+	if err := o.storage.Compact(ctx, compacted); err != nil {
+		return fmt.Errorf("failed to compact outbox log: %w", err)
+	}
+
+	o.done = 0
+
+	return nil
+}
+
+// resolve maps a result to the record marking the event done, or nil if the event stays pending
+func (o *Outbox) resolve(batch []entry, item api.PublishEventResultItem) (*Record, error) {
+	status, err := item.Discriminator()
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid result: %w", ErrPublish, err)
+	}
+
+	switch api.PublishEventStatus(status) {
+	case api.PublishEventStatusAccepted:
+		accepted, err := item.AsPublishEventAcceptedResult()
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid result: %w", ErrPublish, err)
+		}
+
+		e, err := at(batch, accepted.Index)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Record{Seq: e.seq, Op: OpAccepted}, nil
+	case api.PublishEventStatusError:
+		failed, err := item.AsPublishEventErrorResult()
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid result: %w", ErrPublish, err)
+		}
+
+		if failed.Retriable {
+			return nil, nil
+		}
+
+		e, err := at(batch, failed.Index)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Record{Seq: e.seq, Op: OpRejected, Rejection: &Rejection{
+			Chain:      e.chain,
+			Event:      e.event,
+			Error:      failed.Error,
+			RejectedAt: o.now(),
+		}}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown result status %s", ErrPublish, status)
+	}
+}
+
+func (o *Outbox) remove(done []Record) {
+	seqs := make(map[uint64]bool, len(done))
+	for _, record := range done {
+		seqs[record.Seq] = true
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	pending := o.pending[:0]
+	for _, e := range o.pending {
+		if !seqs[e.seq] {
+			pending = append(pending, e)
+		}
+	}
+	o.pending = pending
+}
+
+// validate rejects events the backend would reject permanently, and events without the eventID identifying them in the log
+func validate(event api.Event) error {
+	eventID, err := event.GetEventID()
+	if err != nil {
+		return err
+	}
+
+	if eventID == "" {
+		return errors.New("eventID is required")
+	}
+
+	return event.Validate()
+}
+
+func at(batch []entry, index int) (entry, error) {
+	if index < 0 || index >= len(batch) {
+		return entry{}, fmt.Errorf("%w: result index %d out of range", ErrPublish, index)
+	}
+
+	return batch[index], nil
+}
+
+func appended(e entry) Record {
+	return Record{Seq: e.seq, Op: OpAppended, Chain: e.chain, Event: &e.event}
+}
+
+// replay returns the events that are appended but neither accepted nor rejected, in order
+func replay(records []Record) []entry {
+	done := make(map[uint64]bool)
+	for _, record := range records {
+		if record.Op == OpAccepted || record.Op == OpRejected {
+			done[record.Seq] = true
+		}
+	}
+
+	var pending []entry
+	for _, record := range records {
+		if record.Op == OpAppended && record.Event != nil && !done[record.Seq] {
+			pending = append(pending, entry{seq: record.Seq, chain: record.Chain, event: *record.Event})
+		}
+	}
+
+	return pending
+}
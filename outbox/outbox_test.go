@@ -0,0 +1,185 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/outbox"
+)
+
+const chain = "ethereum"
+
+// backend accepts events unless their IDs start with "retry" or "reject"
+type backend struct {
+	mu       sync.Mutex
+	down     bool
+	retry    bool
+	accepted []string
+}
+
+func (b *backend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.down {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var request api.PublishEventsRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var result api.PublishEventsResult
+	for i, event := range request.Events {
+		var item api.PublishEventResultItem
+		id := event.EventID()
+
+		switch {
+		case strings.HasPrefix(id, "retry") && b.retry:
+			_ = item.FromPublishEventErrorResult(api.PublishEventErrorResult{Index: i, Status: api.PublishEventStatusError, Error: "busy", Retriable: true})
+		case strings.HasPrefix(id, "reject"):
+			_ = item.FromPublishEventErrorResult(api.PublishEventErrorResult{Index: i, Status: api.PublishEventStatusError, Error: "unknown message"})
+		default:
+			b.accepted = append(b.accepted, id)
+			_ = item.FromPublishEventAcceptedResult(api.PublishEventAcceptedResult{Index: i, Status: api.PublishEventStatusAccepted})
+		}
+		result.Results = append(result.Results, item)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func setup(t *testing.T) (*backend, api.ClientWithResponsesInterface) {
+	t.Helper()
+
+	b := &backend{retry: true}
+	server := httptest.NewServer(b)
+	t.Cleanup(server.Close)
+
+	return b, funcs.Must(api.NewClientWithResponses(server.URL))
+}
+
+func event(id string) api.Event {
+	return apitest.CallEvent().With(func(e *api.CallEvent) { e.EventID = id }).Build()
+}
+
+func TestOutbox_Flush(t *testing.T) {
+	ctx := context.Background()
+	b, client := setup(t)
+	storage := funcs.Must(outbox.NewFileStorage(t.TempDir()))
+
+	o := funcs.Must(outbox.Open(ctx, storage, client, outbox.WithBatchSize(2)))
+	require.NoError(t, o.Add(ctx, chain, event("a"), event("retry"), event("reject"), event("b")))
+	assert.Equal(t, 4, o.Pending())
+
+	result := funcs.Must(o.Flush(ctx))
+	assert.Equal(t, outbox.FlushResult{Accepted: 2, Retried: 1, Rejected: 1}, result)
+	assert.Equal(t, []string{"a", "b"}, b.accepted)
+	assert.Equal(t, 1, o.Pending())
+
+	rejections := funcs.Must(o.Rejections(ctx))
+	require.Len(t, rejections, 1)
+	assert.Equal(t, "reject", rejections[0].Event.EventID())
+	assert.Equal(t, "unknown message", rejections[0].Error)
+
+	b.retry = false
+	result = funcs.Must(o.Flush(ctx))
+	assert.Equal(t, outbox.FlushResult{Accepted: 1}, result)
+	assert.Equal(t, []string{"a", "b", "retry"}, b.accepted)
+	assert.Zero(t, o.Pending())
+
+	t.Run("should reject invalid events", func(t *testing.T) {
+		assert.Error(t, o.Add(ctx, chain, api.Event{}))
+		assert.Zero(t, o.Pending())
+	})
+}
+
+func TestOutbox_BackendDown(t *testing.T) {
+	ctx := context.Background()
+	b, client := setup(t)
+	b.down = true
+
+	o := funcs.Must(outbox.Open(ctx, funcs.Must(outbox.NewFileStorage(t.TempDir())), client))
+	require.NoError(t, o.Add(ctx, chain, event("a")))
+
+	_, err := o.Flush(ctx)
+	assert.ErrorIs(t, err, outbox.ErrPublish)
+	assert.Equal(t, 1, o.Pending())
+}
+
+func TestOutbox_Restart(t *testing.T) {
+	ctx := context.Background()
+	b, client := setup(t)
+	dir := t.TempDir()
+
+	o := funcs.Must(outbox.Open(ctx, funcs.Must(outbox.NewFileStorage(dir)), client))
+	require.NoError(t, o.Add(ctx, chain, event("a"), event("retry")))
+	funcs.Must(o.Flush(ctx))
+	require.NoError(t, o.Add(ctx, "avalanche", event("c")))
+
+	// a crash during an append leaves a partial line behind
+	log := funcs.Must(os.OpenFile(filepath.Join(dir, "outbox.jsonl"), os.O_WRONLY|os.O_APPEND, 0))
+	_ = funcs.Must(log.WriteString(`{"seq":7,"op":"APP`))
+	require.NoError(t, log.Close())
+
+	reopened := funcs.Must(outbox.Open(ctx, funcs.Must(outbox.NewFileStorage(dir)), client))
+	assert.Equal(t, 2, reopened.Pending())
+
+	b.retry = false
+	result := funcs.Must(reopened.Flush(ctx))
+	assert.Equal(t, outbox.FlushResult{Accepted: 2}, result)
+	assert.Equal(t, []string{"a", "retry", "c"}, b.accepted)
+
+	records := funcs.Must(funcs.Must(outbox.NewFileStorage(dir)).Records(ctx))
+	assert.Len(t, records, 4, "log must be compacted to the pending events on open")
+	assert.Zero(t, funcs.Must(outbox.Open(ctx, funcs.Must(outbox.NewFileStorage(dir)), client)).Pending())
+}
+
+func TestOutbox_CompactThreshold(t *testing.T) {
+	ctx := context.Background()
+	b, client := setup(t)
+	dir := t.TempDir()
+	storage := funcs.Must(outbox.NewFileStorage(dir))
+
+	o := funcs.Must(outbox.Open(ctx, storage, client, outbox.WithCompactThreshold(3)))
+	require.NoError(t, o.Add(ctx, chain, event("a"), event("reject")))
+	assert.Equal(t, outbox.FlushResult{Accepted: 1, Rejected: 1}, funcs.Must(o.Flush(ctx)))
+	assert.Len(t, funcs.Must(storage.Records(ctx)), 4, "log must not be compacted below the threshold")
+
+	require.NoError(t, o.Add(ctx, chain, event("b"), event("retry")))
+	assert.Equal(t, outbox.FlushResult{Accepted: 1, Retried: 1}, funcs.Must(o.Flush(ctx)))
+
+	records := funcs.Must(storage.Records(ctx))
+	require.Len(t, records, 2, "log must be compacted to the rejections and the pending events")
+	assert.Equal(t, outbox.OpRejected, records[0].Op)
+	assert.Equal(t, outbox.OpAppended, records[1].Op)
+
+	reopened := funcs.Must(outbox.Open(ctx, funcs.Must(outbox.NewFileStorage(dir)), client))
+	assert.Equal(t, 1, reopened.Pending())
+
+	rejections := funcs.Must(reopened.Rejections(ctx))
+	require.Len(t, rejections, 1)
+	assert.Equal(t, "reject", rejections[0].Event.EventID())
+
+	b.retry = false
+	require.NoError(t, reopened.Add(ctx, chain, event("c")))
+	assert.Equal(t, outbox.FlushResult{Accepted: 2}, funcs.Must(reopened.Flush(ctx)))
+	assert.Equal(t, []string{"a", "b", "retry", "c"}, b.accepted)
+}
@@ -0,0 +1,48 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+// Op is the kind of a log record
+type Op string
+
+const (
+	// OpAppended records an event added to the outbox
+	OpAppended Op = "APPENDED"
+	// OpAccepted records an event accepted by the backend
+	OpAccepted Op = "ACCEPTED"
+	// OpRejected records an event rejected by the backend with a non-retriable error. The record carries the rejection
+	// and is kept when the log is compacted.
+	OpRejected Op = "REJECTED"
+)
+
+// Record is an entry of the outbox log. Events are pending from their OpAppended record until an OpAccepted or OpRejected record with the same sequence number.
+type Record struct {
+	Seq       uint64     `json:"seq"`
+	Op        Op         `json:"op"`
+	Chain     string     `json:"chain,omitempty"`
+	Event     *api.Event `json:"event,omitempty"`
+	Rejection *Rejection `json:"rejection,omitempty"`
+}
+
+// Rejection is an event the backend rejected with a non-retriable error
+type Rejection struct {
+	Chain      string    `json:"chain"`
+	Event      api.Event `json:"event"`
+	Error      string    `json:"error"`
+	RejectedAt time.Time `json:"rejectedAt"`
+}
+
+// Storage persists the outbox. Writes must be durable when they return, so that no event is lost on a crash.
+type Storage interface {
+	// Append appends records to the log
+	Append(ctx context.Context, records ...Record) error
+	// Records returns the records of the log in the order they were appended
+	Records(ctx context.Context) ([]Record, error)
+	// Compact atomically replaces the log with the given records
+	Compact(ctx context.Context, records []Record) error
+}
@@ -0,0 +1,37 @@
+package poller
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type taskCursorKey struct{}
+
+// TaskCursor is the cursor to save once a task is handled, passed to the TaskHandler with the context.
+// Handlers keeping their state in the cursor store's database can commit the cursor atomically with the effects of the task,
+// e.g. with cursor.SaveTx in their own bbolt transaction, and then call MarkCommitted, so that the poller doesn't save it again.
+// Otherwise the poller saves the cursor after the handler returns, and a crash in between makes the task be handled again.
+type TaskCursor struct {
+	// Key identifies the cursor of the poller in the cursor store
+	Key string
+	// After is the cursor value to save, i.e. the ID of the handled task
+	After uuid.UUID
+
+	committed bool
+}
+
+// MarkCommitted tells the poller that the handler saved the cursor itself
+func (c *TaskCursor) MarkCommitted() {
+	c.committed = true
+}
+
+// CursorFromContext returns the cursor of the task handled with ctx. It's only available to pollers with a cursor store, see WithCursorStore.
+func CursorFromContext(ctx context.Context) (*TaskCursor, bool) {
+	c, ok := ctx.Value(taskCursorKey{}).(*TaskCursor)
+	return c, ok
+}
+
+func withTaskCursor(ctx context.Context, c *TaskCursor) context.Context {
+	return context.WithValue(ctx, taskCursorKey{}, c)
+}
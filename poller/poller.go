@@ -0,0 +1,360 @@
+// Package poller fetches tasks of a chain with GetTasks and hands them to a TaskHandler one by one, in order.
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/cursor"
+)
+
+const (
+	defaultInterval = 5 * time.Second
+	defaultLimit    = 20
+	// maxAckDetails is the maximum length of AckTask details, as specified by the schema
+	maxAckDetails = 1000
+	// maxWait is the maximum wait of GetTasks, as specified by the schema
+	maxWait = 60 * time.Second
+)
+
+var (
+	// ErrFetchTasks is an error when tasks couldn't be fetched. Run retries such errors on the next tick.
+	ErrFetchTasks = errors.New("failed to fetch tasks")
+	// ErrAckTask is an error when a task couldn't be acknowledged, see WithAcks
+	ErrAckTask = errors.New("failed to acknowledge task")
+	// ErrTaskFailed is returned by handlers that dealt with a failed task themselves, e.g. by recording it in a dead-letter store.
+	// The poller commits such tasks and carries on, and acknowledges them as FAILED.
+	ErrTaskFailed = errors.New("task failed")
+)
+
+// AckErrorHandler is notified of acknowledgements that couldn't be sent
+type AckErrorHandler func(task api.TaskItem, status api.TaskAckStatus, err error)
+
+// TaskHandler handles tasks of a chain. Returning an error stops the poller before the task is committed,
+// so that the task is fetched again when the poller restarts, unless the error wraps ErrTaskFailed.
+type TaskHandler interface {
+	HandleTask(ctx context.Context, task api.TaskItem) error
+}
+
+// TaskHandlerFunc is an adapter to use ordinary functions as TaskHandler
+type TaskHandlerFunc func(ctx context.Context, task api.TaskItem) error
+
+// HandleTask implements TaskHandler
+func (f TaskHandlerFunc) HandleTask(ctx context.Context, task api.TaskItem) error {
+	return f(ctx, task)
+}
+
+// Poller polls tasks of a single chain
+type Poller struct {
+	client  api.ClientWithResponsesInterface
+	chain   string
+	handler TaskHandler
+
+	interval    time.Duration
+	limit       int
+	wait        time.Duration
+	types       []api.TaskType
+	unknownTask UnknownTaskPolicy
+	cursors     cursor.Store
+	acks        bool
+	onAckError  AckErrorHandler
+
+	mu     sync.Mutex
+	after  *uuid.UUID
+	loaded bool
+}
+
+// Option configures Poller
+type Option func(*Poller)
+
+// WithInterval sets the delay between polls that returned fewer tasks than the limit. Defaults to 5s.
+func WithInterval(interval time.Duration) Option {
+	return func(p *Poller) {
+		p.interval = interval
+	}
+}
+
+// WithLimit sets the maximum number of tasks fetched at once. Defaults to 20.
+func WithLimit(limit int) Option {
+	return func(p *Poller) {
+		p.limit = limit
+	}
+}
+
+// WithLongPoll makes every poll ask the server to hold the request for up to wait until a task arrives,
+// so that tasks are delivered as soon as they're created. Run then polls again right away instead of sleeping for the interval,
+// unless an empty page comes back before the wait passed, i.e. the server doesn't support waiting.
+// The wait is rounded to whole seconds and capped at 60s, the maximum the server accepts, and the client's HTTP timeout must exceed it.
+func WithLongPoll(wait time.Duration) Option {
+	return func(p *Poller) {
+		p.wait = min(wait.Round(time.Second), maxWait)
+	}
+}
+
+// WithTaskTypes makes the poller fetch only tasks of the given types, e.g. for executors specialized in a kind of task.
+// Pollers with different types keep separate cursors in a shared cursor store, see WithCursorStore.
+func WithTaskTypes(types ...api.TaskType) Option {
+	return func(p *Poller) {
+		p.types = slices.Clone(types)
+		slices.Sort(p.types)
+		p.types = slices.Compact(p.types)
+	}
+}
+
+// WithAcks makes the poller acknowledge tasks with AckTask: PROCESSING before the handler runs,
+// DONE once it succeeds and the task is committed, and FAILED with the error if it fails or returns ErrTaskFailed. Unknown tasks aren't acknowledged.
+// Acknowledgements are best effort: failures are passed to onError, if not nil, and never stop the poller.
+func WithAcks(onError AckErrorHandler) Option {
+	return func(p *Poller) {
+		p.acks = true
+		p.onAckError = onError
+	}
+}
+
+// WithUnknownTaskPolicy sets what happens to tasks of types unknown to this version of the api package. Defaults to SkipUnknownTasks.
+func WithUnknownTaskPolicy(policy UnknownTaskPolicy) Option {
+	return func(p *Poller) {
+		p.unknownTask = policy
+	}
+}
+
+// WithStartAfter makes the poller start with tasks following the given task, e.g. the last task handled before a restart
+func WithStartAfter(taskItemID uuid.UUID) Option {
+	return func(p *Poller) {
+		p.after = &taskItemID
+	}
+}
+
+// WithCursorStore makes the poller resume from the chain's cursor in store and save the cursor after every handled task.
+// A task counts as handled only once its cursor is saved, so tasks are handled at least once across restarts.
+// Handlers can save the cursor atomically with their own state instead, see CursorFromContext.
+// A saved cursor takes precedence over WithStartAfter.
+// The cursor is saved under the chain's name, or under the chain's name followed by the task types if filtered, e.g. "ethereum[EXECUTE,REFUND]".
+func WithCursorStore(store cursor.Store) Option {
+	return func(p *Poller) {
+		p.cursors = store
+	}
+}
+
+// New creates a Poller of the chain's tasks
+func New(client api.ClientWithResponsesInterface, chain string, handler TaskHandler, opts ...Option) *Poller {
+	p := &Poller{
+		client:      client,
+		chain:       chain,
+		handler:     handler,
+		interval:    defaultInterval,
+		limit:       defaultLimit,
+		unknownTask: SkipUnknownTasks(),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Run polls tasks until ctx is done or a task fails. Errors fetching tasks are retried.
+func (p *Poller) Run(ctx context.Context) error {
+	for {
+		start := time.Now()
+		n, err := p.Poll(ctx)
+		if err != nil && !errors.Is(err, ErrFetchTasks) {
+			return err
+		}
+
+		// a full page means more tasks are likely waiting
+		if err == nil && (n == p.limit || p.longPolled(n, time.Since(start))) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(p.interval):
+		}
+	}
+}
+
+// longPolled returns true if a poll that returned n tasks after elapsed already waited for tasks on the server.
+// An empty page returned before the wait passed means the server ignores the wait, e.g. because it predates it,
+// so Run must sleep to not poll in a tight loop. A page with tasks may have been cut short by the tasks arriving,
+// and the next poll either waits on the server or comes back empty early.
+func (p *Poller) longPolled(n int, elapsed time.Duration) bool {
+	return p.wait > 0 && (n > 0 || elapsed >= p.wait)
+}
+
+// Poll fetches one page of tasks and handles them. It returns the number of fetched tasks.
+func (p *Poller) Poll(ctx context.Context) (int, error) {
+	after, err := p.start(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	params := &api.GetTasksParams{
+		After: after,
+		Limit: &p.limit,
+	}
+	if p.wait > 0 {
+		wait := int(p.wait / time.Second)
+		params.Wait = &wait
+	}
+	if len(p.types) > 0 {
+		params.Type = &p.types
+	}
+
+	res, err := p.client.GetTasksWithResponse(ctx, p.chain, params)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrFetchTasks, err)
+	}
+
+	if res.StatusCode() != http.StatusOK || res.JSON200 == nil {
+		return 0, fmt.Errorf("%w: unexpected status %s", ErrFetchTasks, res.Status())
+	}
+
+	for _, task := range res.JSON200.Tasks {
+		taskCtx, taskCursor := p.taskContext(ctx, task)
+		handleErr := p.handle(taskCtx, task)
+		if handleErr != nil && !errors.Is(handleErr, ErrTaskFailed) {
+			return 0, fmt.Errorf("failed to handle task %s of type %s: %w", task.ID, task.Type, handleErr)
+		}
+
+		if err := p.commit(ctx, task.ID, taskCursor != nil && taskCursor.committed); err != nil {
+			return 0, fmt.Errorf("failed to commit task %s: %w", task.ID, err)
+		}
+
+		// acknowledged only once committed, so that tasks reported as DONE or FAILED aren't delivered again
+		switch {
+		case !task.Type.IsKnown():
+		case handleErr != nil:
+			p.ack(ctx, task, api.TaskAckStatusFailed, handleErr.Error())
+		default:
+			p.ack(ctx, task, api.TaskAckStatusDone, "")
+		}
+	}
+
+	return len(res.JSON200.Tasks), nil
+}
+
+// Cursor returns ID of the last handled task, or nil if no task was handled yet
+func (p *Poller) Cursor() *uuid.UUID {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.after == nil {
+		return nil
+	}
+
+	after := *p.after
+	return &after
+}
+
+func (p *Poller) handle(ctx context.Context, task api.TaskItem) error {
+	if !task.Type.IsKnown() {
+		return p.unknownTask.HandleUnknownTask(ctx, task)
+	}
+
+	p.ack(ctx, task, api.TaskAckStatusProcessing, "")
+
+	err := p.handler.HandleTask(ctx, task)
+	if err != nil && !errors.Is(err, ErrTaskFailed) {
+		p.ack(ctx, task, api.TaskAckStatusFailed, err.Error())
+	}
+
+	return err
+}
+
+func (p *Poller) ack(ctx context.Context, task api.TaskItem, status api.TaskAckStatus, details string) {
+	if !p.acks {
+		return
+	}
+
+	request := api.AckTaskRequest{Status: status}
+	if details != "" {
+		// drop a rune cut in half
+		details = strings.ToValidUTF8(details[:min(len(details), maxAckDetails)], "")
+		request.Details = &details
+	}
+
+	res, err := p.client.AckTaskWithResponse(ctx, p.chain, task.ID, request)
+	if err == nil && res.StatusCode() != http.StatusNoContent {
+		err = fmt.Errorf("unexpected status %s", res.Status())
+	}
+
+	if err != nil && p.onAckError != nil {
+		p.onAckError(task, status, fmt.Errorf("%w %s as %s: %w", ErrAckTask, task.ID, status, err))
+	}
+}
+
+// start returns the cursor to poll after, loading it from the cursor store on the first poll
+func (p *Poller) start(ctx context.Context) (*uuid.UUID, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cursors != nil && !p.loaded {
+		after, err := p.cursors.Load(ctx, p.cursorKey())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load cursor: %w", err)
+		}
+
+		if after != nil {
+			p.after = after
+		}
+		p.loaded = true
+	}
+
+	if p.after == nil {
+		return nil, nil
+	}
+
+	after := *p.after
+	return &after, nil
+}
+
+// cursorKey identifies the cursor of the poller in the cursor store
+func (p *Poller) cursorKey() string {
+	if len(p.types) == 0 {
+		return p.chain
+	}
+
+	types := make([]string, 0, len(p.types))
+	for _, taskType := range p.types {
+		types = append(types, string(taskType))
+	}
+
+	return fmt.Sprintf("%s[%s]", p.chain, strings.Join(types, ","))
+}
+
+// taskContext passes the cursor of the task to the handler, if the poller keeps cursors in a store
+func (p *Poller) taskContext(ctx context.Context, task api.TaskItem) (context.Context, *TaskCursor) {
+	if p.cursors == nil {
+		return ctx, nil
+	}
+
+	taskCursor := &TaskCursor{Key: p.cursorKey(), After: task.ID}
+	return withTaskCursor(ctx, taskCursor), taskCursor
+}
+
+// commit advances the cursor past the task, saving it to the cursor store unless the handler already did
+func (p *Poller) commit(ctx context.Context, taskItemID uuid.UUID, saved bool) error {
+	if p.cursors != nil && !saved {
+		if err := p.cursors.Save(ctx, p.cursorKey(), taskItemID); err != nil {
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.after = &taskItemID
+	return nil
+}
@@ -0,0 +1,447 @@
+package poller_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/cursor"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/memserver"
+	"github.com/axelarnetwork/amplifier-relayer-api/poller"
+)
+
+const chain = "ethereum"
+
+func setup(t *testing.T, tasks ...api.TaskItem) api.ClientWithResponsesInterface {
+	t.Helper()
+
+	_, client := setupServer(t, tasks...)
+	return client
+}
+
+func setupServer(t *testing.T, tasks ...api.TaskItem) (*memserver.Server, api.ClientWithResponsesInterface) {
+	t.Helper()
+
+	server := memserver.New(memserver.WithChains(chain))
+	for _, task := range tasks {
+		require.NoError(t, server.EnqueueTask(task))
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, server)
+	httpServer := httptest.NewServer(router)
+	t.Cleanup(httpServer.Close)
+
+	return server, funcs.Must(api.NewClientWithResponses(httpServer.URL))
+}
+
+func knownTask() api.TaskItem {
+	return apitest.ExecuteTask().WithItem(func(item *api.TaskItem) { item.Chain = chain }).Build()
+}
+
+func unknownTask(t *testing.T) api.TaskItem {
+	var item api.TaskItem
+	require.NoError(t, json.Unmarshal(funcs.Must(json.Marshal(map[string]any{
+		"id":        uuid.New(),
+		"chain":     chain,
+		"timestamp": time.Now(),
+		"type":      "NEW_TASK",
+		"task":      map[string]any{"field": "value"},
+	})), &item))
+
+	return item
+}
+
+type recorder struct {
+	tasks []uuid.UUID
+}
+
+func (r *recorder) HandleTask(_ context.Context, task api.TaskItem) error {
+	r.tasks = append(r.tasks, task.ID)
+	return nil
+}
+
+func TestPoller_Poll(t *testing.T) {
+	tasks := []api.TaskItem{knownTask(), knownTask(), knownTask()}
+	client := setup(t, tasks...)
+
+	handler := &recorder{}
+	p := poller.New(client, chain, handler, poller.WithLimit(2))
+
+	assert.Equal(t, 2, funcs.Must(p.Poll(context.Background())))
+	assert.Equal(t, 1, funcs.Must(p.Poll(context.Background())))
+	assert.Equal(t, 0, funcs.Must(p.Poll(context.Background())))
+
+	assert.Equal(t, []uuid.UUID{tasks[0].ID, tasks[1].ID, tasks[2].ID}, handler.tasks)
+	assert.Equal(t, tasks[2].ID, *p.Cursor())
+}
+
+func TestPoller_HandlerError(t *testing.T) {
+	tasks := []api.TaskItem{knownTask(), knownTask()}
+	client := setup(t, tasks...)
+
+	failing := poller.TaskHandlerFunc(func(_ context.Context, task api.TaskItem) error {
+		if task.ID == tasks[1].ID {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	p := poller.New(client, chain, failing, poller.WithInterval(time.Millisecond))
+	err := p.Run(context.Background())
+	assert.ErrorContains(t, err, "boom")
+	assert.Equal(t, tasks[0].ID, *p.Cursor(), "failed task must not be committed")
+
+	// a restarted poller resumes from the failed task
+	handler := &recorder{}
+	_, err = poller.New(client, chain, handler, poller.WithStartAfter(*p.Cursor())).Poll(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{tasks[1].ID}, handler.tasks)
+}
+
+func TestPoller_UnknownTasks(t *testing.T) {
+	unknown := unknownTask(t)
+	tasks := []api.TaskItem{knownTask(), unknown, knownTask()}
+
+	t.Run("should skip by default", func(t *testing.T) {
+		handler := &recorder{}
+		p := poller.New(setup(t, tasks...), chain, handler)
+
+		_, err := p.Poll(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{tasks[0].ID, tasks[2].ID}, handler.tasks)
+		assert.Equal(t, tasks[2].ID, *p.Cursor())
+	})
+
+	t.Run("should park", func(t *testing.T) {
+		var parked []api.TaskItem
+		handler := &recorder{}
+		p := poller.New(setup(t, tasks...), chain, handler, poller.WithUnknownTaskPolicy(
+			poller.ParkUnknownTasks(func(_ context.Context, task api.TaskItem) error {
+				parked = append(parked, task)
+				return nil
+			}),
+		))
+
+		_, err := p.Poll(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{tasks[0].ID, tasks[2].ID}, handler.tasks)
+		require.Len(t, parked, 1)
+		assert.Equal(t, unknown.ID, parked[0].ID)
+		assert.JSONEq(t, `{"field":"value"}`, string(funcs.Must(parked[0].RawTask())))
+	})
+
+	t.Run("should fail", func(t *testing.T) {
+		handler := &recorder{}
+		p := poller.New(setup(t, tasks...), chain, handler, poller.WithUnknownTaskPolicy(poller.FailOnUnknownTasks()))
+
+		_, err := p.Poll(context.Background())
+		assert.ErrorIs(t, err, api.ErrUnknownTaskType)
+		assert.Equal(t, []uuid.UUID{tasks[0].ID}, handler.tasks)
+		assert.Equal(t, tasks[0].ID, *p.Cursor())
+	})
+}
+
+type failingStore struct {
+	cursor.Store
+}
+
+func (failingStore) Save(context.Context, string, uuid.UUID) error {
+	return errors.New("disk full")
+}
+
+func TestPoller_CursorStore(t *testing.T) {
+	tasks := []api.TaskItem{knownTask(), knownTask(), knownTask()}
+	client := setup(t, tasks...)
+	store := cursor.NewMemoryStore()
+	require.NoError(t, store.Save(context.Background(), "other-chain", tasks[2].ID))
+
+	handler := &recorder{}
+	p := poller.New(client, chain, handler, poller.WithLimit(1), poller.WithCursorStore(store))
+	funcs.Must(p.Poll(context.Background()))
+	assert.Equal(t, tasks[0].ID, *funcs.Must(store.Load(context.Background(), chain)))
+
+	// a restarted poller resumes from the saved cursor rather than the start
+	handler = &recorder{}
+	p = poller.New(client, chain, handler, poller.WithStartAfter(tasks[1].ID), poller.WithCursorStore(store))
+	funcs.Must(p.Poll(context.Background()))
+	assert.Equal(t, []uuid.UUID{tasks[1].ID, tasks[2].ID}, handler.tasks)
+	assert.Equal(t, tasks[2].ID, *funcs.Must(store.Load(context.Background(), chain)))
+
+	t.Run("should not advance if the cursor can't be saved", func(t *testing.T) {
+		handler := &recorder{}
+		p := poller.New(client, chain, handler, poller.WithCursorStore(failingStore{cursor.NewMemoryStore()}))
+
+		_, err := p.Poll(context.Background())
+		assert.ErrorContains(t, err, "disk full")
+		assert.Nil(t, p.Cursor())
+		assert.Equal(t, []uuid.UUID{tasks[0].ID}, handler.tasks)
+	})
+}
+
+// countingStore counts cursors saved by the poller
+type countingStore struct {
+	cursor.Store
+	saves int
+}
+
+func (s *countingStore) Save(ctx context.Context, chain string, after uuid.UUID) error {
+	s.saves++
+	return s.Store.Save(ctx, chain, after)
+}
+
+func TestPoller_HandlerCommitsCursor(t *testing.T) {
+	tasks := []api.TaskItem{knownTask(), knownTask()}
+	client := setup(t, tasks...)
+
+	db := funcs.Must(bolt.Open(filepath.Join(t.TempDir(), "relayer.db"), 0o600, nil))
+	t.Cleanup(func() { _ = db.Close() })
+	store := &countingStore{Store: funcs.Must(cursor.NewBoltStore(db))}
+
+	handler := poller.TaskHandlerFunc(func(ctx context.Context, task api.TaskItem) error {
+		taskCursor, ok := poller.CursorFromContext(ctx)
+		require.True(t, ok)
+		assert.Equal(t, task.ID, taskCursor.After)
+
+		// the effects of the task and the cursor are committed in a single transaction
+		err := db.Update(func(tx *bolt.Tx) error {
+			state, err := tx.CreateBucketIfNotExists([]byte("handled"))
+			if err != nil {
+				return err
+			}
+
+			if err := state.Put(task.ID[:], []byte{1}); err != nil {
+				return err
+			}
+
+			return cursor.SaveTx(tx, taskCursor.Key, taskCursor.After)
+		})
+		if err != nil {
+			return err
+		}
+
+		taskCursor.MarkCommitted()
+		return nil
+	})
+
+	p := poller.New(client, chain, handler, poller.WithCursorStore(store))
+	assert.Equal(t, 2, funcs.Must(p.Poll(context.Background())))
+	assert.Zero(t, store.saves, "cursors committed by the handler must not be saved again")
+	assert.Equal(t, tasks[1].ID, *p.Cursor())
+	assert.Equal(t, tasks[1].ID, *funcs.Must(store.Load(context.Background(), chain)))
+
+	t.Run("should not pass the cursor without a store", func(t *testing.T) {
+		handler := poller.TaskHandlerFunc(func(ctx context.Context, _ api.TaskItem) error {
+			_, ok := poller.CursorFromContext(ctx)
+			assert.False(t, ok)
+			return nil
+		})
+		funcs.Must(poller.New(client, chain, handler).Poll(context.Background()))
+	})
+}
+
+func TestPoller_LongPoll(t *testing.T) {
+	server, client := setupServer(t)
+	task := knownTask()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var received []uuid.UUID
+	handler := poller.TaskHandlerFunc(func(_ context.Context, task api.TaskItem) error {
+		received = append(received, task.ID)
+		cancel()
+		return nil
+	})
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = server.EnqueueTask(task)
+	}()
+
+	// the interval is never waited for, since the server holds the polls
+	err := poller.New(client, chain, handler, poller.WithInterval(time.Hour), poller.WithLongPoll(30*time.Second)).Run(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []uuid.UUID{task.ID}, received)
+}
+
+// waitIgnoringServer is a memserver.Server that predates the wait parameter of GetTasks
+type waitIgnoringServer struct {
+	*memserver.Server
+	polls atomic.Int32
+}
+
+func (s *waitIgnoringServer) GetTasks(c *gin.Context, chain api.Chain, params api.GetTasksParams) {
+	s.polls.Add(1)
+	params.Wait = nil
+	s.Server.GetTasks(c, chain, params)
+}
+
+func TestPoller_LongPoll_WaitIgnored(t *testing.T) {
+	server := &waitIgnoringServer{Server: memserver.New(memserver.WithChains(chain))}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api.RegisterServer(router, server)
+	httpServer := httptest.NewServer(router)
+	t.Cleanup(httpServer.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	p := poller.New(funcs.Must(api.NewClientWithResponses(httpServer.URL)), chain, &recorder{},
+		poller.WithInterval(50*time.Millisecond), poller.WithLongPoll(30*time.Second))
+	assert.ErrorIs(t, p.Run(ctx), context.DeadlineExceeded)
+
+	// empty pages that return right away must be followed by the interval rather than a busy loop
+	assert.LessOrEqual(t, server.polls.Load(), int32(5))
+}
+
+func TestPoller_LongPoll_MaxWait(t *testing.T) {
+	var wait string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wait = r.URL.Query().Get("wait")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"tasks": []}`))
+	}))
+	t.Cleanup(server.Close)
+
+	p := poller.New(funcs.Must(api.NewClientWithResponses(server.URL)), chain, &recorder{}, poller.WithLongPoll(5*time.Minute))
+	funcs.Must(p.Poll(context.Background()))
+	assert.Equal(t, "60", wait, "the wait must not exceed the maximum of the schema")
+}
+
+func TestPoller_TaskTypes(t *testing.T) {
+	onChain := func(item *api.TaskItem) { item.Chain = chain }
+	tasks := []api.TaskItem{
+		apitest.ExecuteTask().WithItem(onChain).Build(),
+		apitest.GatewayTransactionTask().WithItem(onChain).Build(),
+		apitest.RefundTask().WithItem(onChain).Build(),
+		apitest.ExecuteTask().WithItem(onChain).Build(),
+	}
+	client := setup(t, tasks...)
+	store := cursor.NewMemoryStore()
+
+	executor := &recorder{}
+	p := poller.New(client, chain, executor, poller.WithCursorStore(store), poller.WithTaskTypes(api.TaskTypeRefund, api.TaskTypeExecute))
+	funcs.Must(p.Poll(context.Background()))
+	assert.Equal(t, []uuid.UUID{tasks[0].ID, tasks[2].ID, tasks[3].ID}, executor.tasks)
+
+	gateway := &recorder{}
+	p = poller.New(client, chain, gateway, poller.WithCursorStore(store), poller.WithTaskTypes(api.TaskTypeGatewayTransaction))
+	funcs.Must(p.Poll(context.Background()))
+	assert.Equal(t, []uuid.UUID{tasks[1].ID}, gateway.tasks, "pollers of other types must keep their own cursors")
+
+	assert.Equal(t, tasks[3].ID, *funcs.Must(store.Load(context.Background(), chain+"[EXECUTE,REFUND]")))
+	assert.Equal(t, tasks[1].ID, *funcs.Must(store.Load(context.Background(), chain+"[GATEWAY_TX]")))
+	assert.Nil(t, funcs.Must(store.Load(context.Background(), chain)))
+}
+
+func TestPoller_Acks(t *testing.T) {
+	tasks := []api.TaskItem{knownTask(), knownTask()}
+	server, client := setupServer(t, tasks...)
+
+	failing := poller.TaskHandlerFunc(func(_ context.Context, task api.TaskItem) error {
+		if task.ID == tasks[1].ID {
+			return errors.New("insufficient balance")
+		}
+		return nil
+	})
+
+	var ackErrs []error
+	onAckError := func(_ api.TaskItem, _ api.TaskAckStatus, err error) { ackErrs = append(ackErrs, err) }
+
+	_, err := poller.New(client, chain, failing, poller.WithAcks(onAckError)).Poll(context.Background())
+	assert.ErrorContains(t, err, "insufficient balance")
+	assert.Empty(t, ackErrs)
+
+	done, ok := server.TaskAck(chain, tasks[0].ID)
+	require.True(t, ok)
+	assert.Equal(t, api.TaskAckStatusDone, done.Status)
+
+	failed, ok := server.TaskAck(chain, tasks[1].ID)
+	require.True(t, ok)
+	assert.Equal(t, api.TaskAckStatusFailed, failed.Status)
+	assert.Equal(t, "insufficient balance", failed.Details)
+
+	t.Run("should not ack DONE before the task is committed", func(t *testing.T) {
+		task := knownTask()
+		server, client := setupServer(t, task)
+
+		p := poller.New(client, chain, &recorder{}, poller.WithAcks(onAckError), poller.WithCursorStore(failingStore{cursor.NewMemoryStore()}))
+		_, err := p.Poll(context.Background())
+		assert.ErrorContains(t, err, "disk full")
+
+		ack, ok := server.TaskAck(chain, task.ID)
+		require.True(t, ok)
+		assert.Equal(t, api.TaskAckStatusProcessing, ack.Status)
+	})
+
+	t.Run("should ack FAILED and carry on if the handler took care of the failure", func(t *testing.T) {
+		tasks := []api.TaskItem{knownTask(), knownTask()}
+		server, client := setupServer(t, tasks...)
+
+		deadLettered := poller.TaskHandlerFunc(func(_ context.Context, task api.TaskItem) error {
+			if task.ID == tasks[0].ID {
+				return fmt.Errorf("%w: insufficient balance", poller.ErrTaskFailed)
+			}
+			return nil
+		})
+
+		p := poller.New(client, chain, deadLettered, poller.WithAcks(onAckError))
+		n, err := p.Poll(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, tasks[1].ID, *p.Cursor())
+
+		failed, ok := server.TaskAck(chain, tasks[0].ID)
+		require.True(t, ok)
+		assert.Equal(t, api.TaskAckStatusFailed, failed.Status)
+		assert.Equal(t, "task failed: insufficient balance", failed.Details)
+
+		done, ok := server.TaskAck(chain, tasks[1].ID)
+		require.True(t, ok)
+		assert.Equal(t, api.TaskAckStatusDone, done.Status)
+	})
+
+	t.Run("should not stop on failed acks", func(t *testing.T) {
+		// the first task is done, so acknowledging it as PROCESSING again is rejected
+		handler := &recorder{}
+		_, err := poller.New(client, chain, handler, poller.WithAcks(onAckError)).Poll(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{tasks[0].ID, tasks[1].ID}, handler.tasks)
+
+		require.Len(t, ackErrs, 1)
+		assert.ErrorIs(t, ackErrs[0], poller.ErrAckTask)
+		assert.ErrorContains(t, ackErrs[0], "409")
+	})
+}
+
+func TestPoller_Run_RetriesFetchErrors(t *testing.T) {
+	client := setup(t)
+	p := poller.New(client, "unknown-chain", &recorder{}, poller.WithInterval(time.Millisecond))
+
+	_, err := p.Poll(context.Background())
+	assert.ErrorIs(t, err, poller.ErrFetchTasks)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, p.Run(ctx), context.DeadlineExceeded)
+}
@@ -0,0 +1,50 @@
+package poller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+// UnknownTaskPolicy decides what happens to tasks of types unknown to this version of the api package,
+// e.g. types added to the schema after the relayer was built. The task's payload is available with api.TaskItem.RawTask.
+// Returning nil moves the poller past the task, returning an error stops the poller.
+type UnknownTaskPolicy interface {
+	HandleUnknownTask(ctx context.Context, task api.TaskItem) error
+}
+
+// UnknownTaskPolicyFunc is an adapter to use ordinary functions as UnknownTaskPolicy
+type UnknownTaskPolicyFunc func(ctx context.Context, task api.TaskItem) error
+
+// HandleUnknownTask implements UnknownTaskPolicy
+func (f UnknownTaskPolicyFunc) HandleUnknownTask(ctx context.Context, task api.TaskItem) error {
+	return f(ctx, task)
+}
+
+// SkipUnknownTasks ignores unknown tasks, so that a schema release never stops a relayer that hasn't been upgraded yet
+func SkipUnknownTasks() UnknownTaskPolicy {
+	return UnknownTaskPolicyFunc(func(context.Context, api.TaskItem) error {
+		return nil
+	})
+}
+
+// ParkUnknownTasks hands unknown tasks to park, e.g. to store them until the relayer is upgraded, and moves on.
+// The poller stops if park fails, so that no task is lost.
+func ParkUnknownTasks(park func(ctx context.Context, task api.TaskItem) error) UnknownTaskPolicy {
+	return UnknownTaskPolicyFunc(func(ctx context.Context, task api.TaskItem) error {
+		if err := park(ctx, task); err != nil {
+			return fmt.Errorf("failed to park task: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// FailOnUnknownTasks stops the poller on unknown tasks with api.ErrUnknownTaskType.
+// It suits relayers that must not miss any task and are upgraded before the backend.
+func FailOnUnknownTasks() UnknownTaskPolicy {
+	return UnknownTaskPolicyFunc(func(_ context.Context, task api.TaskItem) error {
+		return fmt.Errorf("%w: %s", api.ErrUnknownTaskType, task.Type)
+	})
+}
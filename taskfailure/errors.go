@@ -0,0 +1,47 @@
+package taskfailure
+
+import (
+	"errors"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+)
+
+var (
+	// ErrInsufficientGas is an error when the task couldn't be executed because the available gas didn't cover its cost.
+	// Wrap it with fmt.Errorf("%w: ...", ErrInsufficientGas) to report INSUFFICIENT_GAS.
+	ErrInsufficientGas = errors.New("insufficient gas")
+	// ErrTxReverted is an error when the transaction executing the task was reverted on chain.
+	// Wrap it with fmt.Errorf("%w: ...", ErrTxReverted) to report TX_REVERTED.
+	ErrTxReverted = errors.New("transaction reverted")
+)
+
+// CustomError is an integration-specific failure reported as CUSTOM
+type CustomError struct {
+	Details string
+}
+
+// Error implements error
+func (e *CustomError) Error() string {
+	return e.Details
+}
+
+// Custom returns an error reported as CUSTOM with the given details
+func Custom(details string) error {
+	return &CustomError{Details: details}
+}
+
+// Reason classifies the error. Errors that aren't classified are reported as ERROR.
+func Reason(err error) api.CannotExecuteTaskReason {
+	var custom *CustomError
+
+	switch {
+	case errors.Is(err, ErrInsufficientGas):
+		return api.CannotExecuteTaskReasonInsufficientGas
+	case errors.Is(err, ErrTxReverted):
+		return api.CannotExecuteTaskReasonTxReverted
+	case errors.As(err, &custom):
+		return api.CannotExecuteTaskReasonCustom
+	default:
+		return api.CannotExecuteTaskReasonError
+	}
+}
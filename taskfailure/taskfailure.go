@@ -0,0 +1,91 @@
+// Package taskfailure builds CANNOT_EXECUTE_TASK events from task failures.
+// The reason is derived from the error, see Reason, and fees spent on the failed attempt are attached as Cost.
+package taskfailure
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/eventid"
+)
+
+// Spend is a fee spent on a failed attempt, e.g. gas of a reverted transaction
+type Spend struct {
+	TxID        string
+	Token       api.UnsignedToken
+	Description string
+}
+
+// NewCannotExecuteTaskEvent builds a CANNOT_EXECUTE_TASK event for a failed attempt of the task.
+// The eventID is derived from the task ID and the attempt with eventid.FromTask, so that republishing the event for the same attempt is idempotent.
+// Spent fees get IDs unique within the event. Details are the error message, or the reason if the message is empty.
+func NewCannotExecuteTaskEvent(task api.TaskItem, attempt int, cause error, spent ...Spend) (api.CannotExecuteTaskEvent, error) {
+	if cause == nil {
+		return api.CannotExecuteTaskEvent{}, errors.New("cause is required")
+	}
+
+	if attempt < 1 {
+		return api.CannotExecuteTaskEvent{}, fmt.Errorf("attempt must be positive, got %d", attempt)
+	}
+
+	eventID := eventid.FromTask(task.ID, uint64(attempt))
+	reason := Reason(cause)
+
+	// the schema requires details, so errors without a message are described by their reason
+	details := cause.Error()
+	if details == "" {
+		details = string(reason)
+	}
+
+	event := api.CannotExecuteTaskEvent{
+		EventID:    eventID,
+		TaskItemID: task.ID,
+		Reason:     reason,
+		Details:    details,
+	}
+
+	if len(spent) > 0 {
+		fees := make(api.Fees, 0, len(spent))
+		for i, spend := range spent {
+			fee := api.Fee{
+				ID:    fmt.Sprintf("%s-fee-%d", eventID, i),
+				Token: spend.Token,
+			}
+			if spend.TxID != "" {
+				fee.Meta = &api.FeeMetadata{TxID: &spend.TxID}
+			}
+			if spend.Description != "" {
+				fee.Description = &spend.Description
+			}
+			fees = append(fees, fee)
+		}
+
+		var cost api.Cost
+		if err := cost.FromFees(fees); err != nil {
+			return api.CannotExecuteTaskEvent{}, err
+		}
+		event.Cost = &cost
+	}
+
+	return event, nil
+}
+
+// NewEvent builds a CANNOT_EXECUTE_TASK event like NewCannotExecuteTaskEvent and wraps it into a valid api.Event ready to publish
+func NewEvent(task api.TaskItem, attempt int, cause error, spent ...Spend) (api.Event, error) {
+	cannotExecuteTask, err := NewCannotExecuteTaskEvent(task, attempt, cause, spent...)
+	if err != nil {
+		return api.Event{}, err
+	}
+
+	var event api.Event
+	if err := event.FromCannotExecuteTaskEvent(cannotExecuteTask); err != nil {
+		return api.Event{}, err
+	}
+
+	if err := event.Validate(); err != nil {
+		return api.Event{}, fmt.Errorf("invalid event: %w", err)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,99 @@
+package taskfailure_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/eventid"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/taskfailure"
+)
+
+func TestReason(t *testing.T) {
+	testCases := []struct {
+		err    error
+		reason api.CannotExecuteTaskReason
+	}{
+		{fmt.Errorf("%w: need 100, have 10", taskfailure.ErrInsufficientGas), api.CannotExecuteTaskReasonInsufficientGas},
+		{fmt.Errorf("execute: %w", fmt.Errorf("%w: out of gas", taskfailure.ErrTxReverted)), api.CannotExecuteTaskReasonTxReverted},
+		{fmt.Errorf("execute: %w", taskfailure.Custom("message already executed")), api.CannotExecuteTaskReasonCustom},
+		{errors.New("rpc unavailable"), api.CannotExecuteTaskReasonError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.reason), func(t *testing.T) {
+			assert.Equal(t, tc.reason, taskfailure.Reason(tc.err))
+		})
+	}
+}
+
+func TestNewCannotExecuteTaskEvent(t *testing.T) {
+	task := apitest.ExecuteTask().Build()
+	cause := fmt.Errorf("%w: out of gas", taskfailure.ErrTxReverted)
+	spent := []taskfailure.Spend{
+		{TxID: "0xabc", Token: api.UnsignedToken{Amount: "100"}},
+		{TxID: "0xdef", Token: api.UnsignedToken{Amount: "200"}, Description: "retry"},
+	}
+
+	event := funcs.Must(taskfailure.NewCannotExecuteTaskEvent(task, 2, cause, spent...))
+	assert.Equal(t, task.ID, event.TaskItemID)
+	assert.Equal(t, api.CannotExecuteTaskReasonTxReverted, event.Reason)
+	assert.Equal(t, cause.Error(), event.Details)
+
+	require.NotNil(t, event.Cost)
+	fees := funcs.Must(event.Cost.AsFees())
+	require.Len(t, fees, 2)
+	assert.NotEqual(t, fees[0].ID, fees[1].ID)
+	assert.Equal(t, "0xabc", *fees[0].Meta.TxID)
+	assert.Equal(t, "200", fees[1].Token.Amount)
+	assert.Equal(t, "retry", *fees[1].Description)
+
+	t.Run("should be deterministic", func(t *testing.T) {
+		again := funcs.Must(taskfailure.NewCannotExecuteTaskEvent(task, 2, cause, spent...))
+		assert.Equal(t, event, again)
+		assert.Equal(t, eventid.FromTask(task.ID, 2), event.EventID)
+
+		next := funcs.Must(taskfailure.NewCannotExecuteTaskEvent(task, 3, cause))
+		assert.NotEqual(t, event.EventID, next.EventID)
+		assert.Nil(t, next.Cost)
+
+		other := funcs.Must(taskfailure.NewCannotExecuteTaskEvent(apitest.ExecuteTask().Build(), 2, cause))
+		assert.NotEqual(t, event.EventID, other.EventID)
+	})
+
+	t.Run("should describe errors without a message by their reason", func(t *testing.T) {
+		event := funcs.Must(taskfailure.NewCannotExecuteTaskEvent(task, 1, taskfailure.Custom("")))
+		assert.Equal(t, api.CannotExecuteTaskReasonCustom, event.Reason)
+		assert.Equal(t, "CUSTOM", event.Details)
+
+		event = funcs.Must(taskfailure.NewCannotExecuteTaskEvent(task, 1, fmt.Errorf("%w", errors.New(""))))
+		assert.Equal(t, "ERROR", event.Details)
+	})
+
+	t.Run("should reject invalid input", func(t *testing.T) {
+		_, err := taskfailure.NewCannotExecuteTaskEvent(task, 1, nil)
+		assert.Error(t, err)
+
+		_, err = taskfailure.NewCannotExecuteTaskEvent(task, 0, cause)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewEvent(t *testing.T) {
+	task := apitest.VerifyTask().Build()
+	cause := fmt.Errorf("%w: need 100, have 10", taskfailure.ErrInsufficientGas)
+
+	event := funcs.Must(taskfailure.NewEvent(task, 1, cause, taskfailure.Spend{TxID: "0xabc", Token: api.UnsignedToken{Amount: "1"}}))
+	require.NoError(t, event.Validate())
+	assert.Equal(t, api.EventTypeCannotExecuteTask, event.Type)
+
+	cannotExecuteTask := funcs.Must(event.AsCannotExecuteTaskEvent())
+	assert.Equal(t, api.CannotExecuteTaskReasonInsufficientGas, cannotExecuteTask.Reason)
+	assert.Equal(t, cannotExecuteTask.EventID, event.EventID())
+}
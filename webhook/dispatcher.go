@@ -0,0 +1,166 @@
+// Package webhook pushes tasks to relayers over HTTP, for relayers that can't poll, e.g. on serverless platforms.
+//
+// A Dispatcher POSTs every task as JSON to the relayer's URL, signed with a secret shared with the relayer, see Sign.
+// On the relayer's side, Handler verifies the signature and hands the task to a poller.TaskHandler.
+// Deliveries are retried, so receivers must handle tasks idempotently, e.g. by deduplicating on DeliveryHeader.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/poller"
+)
+
+const (
+	defaultAttempts = 5
+	defaultBackoff  = time.Second
+	maxErrorBody    = 1 << 10
+)
+
+// ErrDeliveryRejected is an error when the receiver permanently rejected a delivery, e.g. because of an invalid signature.
+// Such deliveries aren't retried.
+var ErrDeliveryRejected = errors.New("webhook delivery rejected")
+
+// ErrDeliveryFailed is an error when a delivery failed on all attempts
+var ErrDeliveryFailed = errors.New("webhook delivery failed")
+
+var _ poller.TaskHandler = (*Dispatcher)(nil)
+
+// Dispatcher delivers tasks to a single webhook URL. It's safe for concurrent use.
+type Dispatcher struct {
+	url    string
+	secret []byte
+
+	client   api.HttpRequestDoer
+	attempts int
+	backoff  time.Duration
+	now      func() time.Time
+}
+
+// DispatcherOption configures Dispatcher
+type DispatcherOption func(*Dispatcher)
+
+// WithHTTPClient sets the client deliveries are sent with. Defaults to http.DefaultClient.
+func WithHTTPClient(client api.HttpRequestDoer) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.client = client
+	}
+}
+
+// WithAttempts sets how often a delivery is attempted before it fails. Defaults to 5.
+func WithAttempts(attempts int) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.attempts = max(attempts, 1)
+	}
+}
+
+// WithBackoff sets the delay before the first retry. The delay doubles with every further retry. Defaults to 1s.
+func WithBackoff(backoff time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.backoff = backoff
+	}
+}
+
+// WithDispatcherClock sets the clock deliveries are signed with
+func WithDispatcherClock(now func() time.Time) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.now = now
+	}
+}
+
+// NewDispatcher creates a Dispatcher delivering tasks to url, signed with secret
+func NewDispatcher(url string, secret []byte, opts ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{
+		url:      url,
+		secret:   secret,
+		client:   http.DefaultClient,
+		attempts: defaultAttempts,
+		backoff:  defaultBackoff,
+		now:      time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// HandleTask implements poller.TaskHandler, so that a poller can push the tasks it fetches to the webhook
+func (d *Dispatcher) HandleTask(ctx context.Context, task api.TaskItem) error {
+	return d.Deliver(ctx, task)
+}
+
+// Deliver POSTs the task to the webhook. Network errors, 429 and 5xx responses are retried with exponential backoff,
+// other non-2xx responses fail with ErrDeliveryRejected right away.
+func (d *Dispatcher) Deliver(ctx context.Context, task api.TaskItem) error {
+	body, err := json.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("failed to encode task %s: %w", task.ID, err)
+	}
+
+	backoff := d.backoff
+	var lastErr error
+	for attempt := range d.attempts {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		lastErr = d.send(ctx, task, body)
+		if lastErr == nil || errors.Is(lastErr, ErrDeliveryRejected) {
+			return lastErr
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("%w after %d attempts: %w", ErrDeliveryFailed, d.attempts, lastErr)
+}
+
+func (d *Dispatcher) send(ctx context.Context, task api.TaskItem, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeliveryRejected, err)
+	}
+
+	// every attempt is signed anew, so that retries stay within the receiver's tolerance
+	now := d.now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(SignatureHeader, Sign(d.secret, now, body))
+	req.Header.Set(DeliveryHeader, task.ID.String())
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, res.Body)
+		return nil
+	}
+
+	message, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBody))
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected status %s: %s", res.Status, message)
+	}
+
+	return fmt.Errorf("%w: unexpected status %s: %s", ErrDeliveryRejected, res.Status, message)
+}
@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/poller"
+)
+
+const (
+	defaultTolerance = 5 * time.Minute
+	maxBodySize      = 10 << 20
+)
+
+type receiver struct {
+	secret  []byte
+	handler poller.TaskHandler
+
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+// ReceiverOption configures Handler
+type ReceiverOption func(*receiver)
+
+// WithTolerance sets how far the signing time of a delivery may be from now. Defaults to 5m.
+func WithTolerance(tolerance time.Duration) ReceiverOption {
+	return func(r *receiver) {
+		r.tolerance = tolerance
+	}
+}
+
+// WithReceiverClock sets the clock signing times are checked against
+func WithReceiverClock(now func() time.Time) ReceiverOption {
+	return func(r *receiver) {
+		r.now = now
+	}
+}
+
+// Handler returns a gin handler receiving deliveries of a Dispatcher signed with secret.
+// Verified tasks are handed to handler: it responds 204 if the handler succeeds, and 500 if it fails, so that the delivery is retried.
+// Deliveries with invalid signatures are rejected with 401, malformed tasks with 400.
+func Handler(secret []byte, handler poller.TaskHandler, opts ...ReceiverOption) gin.HandlerFunc {
+	r := &receiver{
+		secret:    secret,
+		handler:   handler,
+		tolerance: defaultTolerance,
+		now:       time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r.handle
+}
+
+func (r *receiver) handle(c *gin.Context) {
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBodySize))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	err = Verify(r.secret, c.GetHeader(SignatureHeader), c.GetHeader(TimestampHeader), body, r.now(), r.tolerance)
+	if err != nil {
+		respondError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	var task api.TaskItem
+	if err := json.Unmarshal(body, &task); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// failed tasks the handler took care of, e.g. dead-lettered ones, mustn't be delivered again
+	if err := r.handler.HandleTask(c.Request.Context(), task); err != nil && !errors.Is(err, poller.ErrTaskFailed) {
+		respondError(c, http.StatusInternalServerError, fmt.Errorf("failed to handle task: %w", err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func respondError(c *gin.Context, status int, err error) {
+	c.JSON(status, api.ErrorResponse{Error: err.Error()})
+}
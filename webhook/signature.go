@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of a delivery as "sha256=<hex>"
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader carries the unix time in seconds the delivery was signed at
+	TimestampHeader = "X-Webhook-Timestamp"
+	// DeliveryHeader carries the ID of the delivered task, so that receivers can deduplicate retried deliveries
+	DeliveryHeader = "X-Webhook-Delivery"
+
+	signaturePrefix = "sha256="
+)
+
+// ErrInvalidSignature is an error when a delivery isn't signed with the shared secret, or was signed too long ago
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Sign returns the signature of the body signed at timestamp. The timestamp is signed too, so that deliveries can't be replayed later.
+func Sign(secret []byte, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and timestamp headers of a delivery. Deliveries signed more than tolerance away from now are rejected.
+func Verify(secret []byte, signature, timestamp string, body []byte, now time.Time, tolerance time.Duration) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: malformed timestamp %q", ErrInvalidSignature, timestamp)
+	}
+
+	signedAt := time.Unix(seconds, 0)
+	if diff := now.Sub(signedAt).Abs(); diff > tolerance {
+		return fmt.Errorf("%w: signed %s away from now", ErrInvalidSignature, diff)
+	}
+
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return fmt.Errorf("%w: unsupported signature scheme", ErrInvalidSignature)
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, signedAt, body))) {
+		return fmt.Errorf("%w: signature mismatch", ErrInvalidSignature)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axelarnetwork/amplifier-relayer-api/api"
+	"github.com/axelarnetwork/amplifier-relayer-api/api/apitest"
+	"github.com/axelarnetwork/amplifier-relayer-api/internal/funcs"
+	"github.com/axelarnetwork/amplifier-relayer-api/poller"
+	"github.com/axelarnetwork/amplifier-relayer-api/webhook"
+)
+
+var secret = []byte("shared-secret")
+
+// relayer records received tasks. It fails as many deliveries as failures before it succeeds.
+type relayer struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	tasks    []api.TaskItem
+}
+
+func (r *relayer) HandleTask(_ context.Context, task api.TaskItem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.attempts++
+	if r.attempts <= r.failures {
+		return errors.New("database unavailable")
+	}
+
+	r.tasks = append(r.tasks, task)
+	return nil
+}
+
+func setup(t *testing.T, handler poller.TaskHandler, opts ...webhook.ReceiverOption) string {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/tasks", webhook.Handler(secret, handler, opts...))
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return server.URL + "/tasks"
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	r := &relayer{}
+	url := setup(t, r)
+	task := apitest.ExecuteTask().Build()
+
+	require.NoError(t, webhook.NewDispatcher(url, secret).Deliver(context.Background(), task))
+	require.Len(t, r.tasks, 1)
+	assert.Equal(t, task.ID, r.tasks[0].ID)
+	assert.Equal(t, task.Type, r.tasks[0].Type)
+	assert.JSONEq(t, string(funcs.Must(task.RawTask())), string(funcs.Must(r.tasks[0].RawTask())))
+}
+
+func TestDispatcher_Retries(t *testing.T) {
+	task := apitest.VerifyTask().Build()
+
+	t.Run("should retry failed deliveries", func(t *testing.T) {
+		r := &relayer{failures: 2}
+		dispatcher := webhook.NewDispatcher(setup(t, r), secret, webhook.WithBackoff(time.Millisecond))
+
+		require.NoError(t, dispatcher.Deliver(context.Background(), task))
+		assert.Equal(t, 3, r.attempts)
+		assert.Len(t, r.tasks, 1)
+	})
+
+	t.Run("should give up after the last attempt", func(t *testing.T) {
+		r := &relayer{failures: 10}
+		dispatcher := webhook.NewDispatcher(setup(t, r), secret, webhook.WithBackoff(time.Millisecond), webhook.WithAttempts(3))
+
+		err := dispatcher.Deliver(context.Background(), task)
+		assert.ErrorIs(t, err, webhook.ErrDeliveryFailed)
+		assert.ErrorContains(t, err, "database unavailable")
+		assert.Equal(t, 3, r.attempts)
+	})
+
+	t.Run("should not retry rejected deliveries", func(t *testing.T) {
+		r := &relayer{}
+		dispatcher := webhook.NewDispatcher(setup(t, r), []byte("wrong-secret"), webhook.WithBackoff(time.Millisecond))
+
+		err := dispatcher.Deliver(context.Background(), task)
+		assert.ErrorIs(t, err, webhook.ErrDeliveryRejected)
+		assert.ErrorContains(t, err, "401")
+		assert.Zero(t, r.attempts)
+	})
+
+	t.Run("should not retry failures the handler took care of", func(t *testing.T) {
+		attempts := 0
+		deadLettered := poller.TaskHandlerFunc(func(context.Context, api.TaskItem) error {
+			attempts++
+			return fmt.Errorf("%w: insufficient balance", poller.ErrTaskFailed)
+		})
+		dispatcher := webhook.NewDispatcher(setup(t, deadLettered), secret, webhook.WithBackoff(time.Millisecond))
+
+		require.NoError(t, dispatcher.Deliver(context.Background(), task))
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("should reject stale deliveries", func(t *testing.T) {
+		r := &relayer{}
+		stale := func() time.Time { return time.Now().Add(-time.Hour) }
+		dispatcher := webhook.NewDispatcher(setup(t, r), secret, webhook.WithDispatcherClock(stale))
+
+		assert.ErrorIs(t, dispatcher.Deliver(context.Background(), task), webhook.ErrDeliveryRejected)
+		assert.Zero(t, r.attempts)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	body := []byte(`{"id":"task"}`)
+	signature := webhook.Sign(secret, now, body)
+	timestamp := "1700000000"
+
+	require.NoError(t, webhook.Verify(secret, signature, timestamp, body, now.Add(time.Minute), 5*time.Minute))
+
+	testCases := []struct {
+		name      string
+		signature string
+		timestamp string
+		body      string
+	}{
+		{"tampered body", signature, timestamp, `{"id":"other"}`},
+		{"tampered timestamp", signature, "1700000001", string(body)},
+		{"missing timestamp", signature, "", string(body)},
+		{"missing signature", "", timestamp, string(body)},
+		{"other scheme", "sha1=" + signature[len("sha256="):], timestamp, string(body)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := webhook.Verify(secret, tc.signature, tc.timestamp, []byte(tc.body), now, 5*time.Minute)
+			assert.ErrorIs(t, err, webhook.ErrInvalidSignature)
+		})
+	}
+
+	assert.ErrorIs(t, webhook.Verify(secret, signature, timestamp, body, now.Add(time.Hour), 5*time.Minute), webhook.ErrInvalidSignature)
+}
+
+func TestHandler_MalformedTask(t *testing.T) {
+	r := &relayer{}
+	url := setup(t, r)
+
+	body := []byte("not a task")
+	now := time.Now()
+
+	req := funcs.Must(http.NewRequest(http.MethodPost, url, bytes.NewReader(body)))
+	req.Header.Set(webhook.TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(secret, now, body))
+
+	res := funcs.Must(http.DefaultClient.Do(req))
+	defer func() { _ = res.Body.Close() }()
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	assert.Zero(t, r.attempts)
+}